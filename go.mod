@@ -1,5 +1,5 @@
 module github.com/dairyo/j2g
 
-go 1.21.3
+go 1.23
 
 require github.com/google/go-cmp v0.6.0
@@ -1,5 +1,7 @@
 package runnable
 
+import "github.com/dairyo/j2g/java/util/function"
+
 /**
 This is a port of java.lang.Runnable.
 
@@ -22,3 +24,16 @@ func WrapNoErr(f func()) Runnable {
 		return nil
 	}
 }
+
+// Recover returns a [Runnable] which calls r and converts a panic in
+// r to a [*function.PanicError] returned as error.
+// If r is nil, this function returns nil.
+func Recover(r Runnable) Runnable {
+	if r == nil {
+		return nil
+	}
+	return func() (err error) {
+		defer function.RecoverAsError(&err)
+		return r()
+	}
+}
@@ -1,6 +1,11 @@
 package runnable
 
-import "testing"
+import (
+	"errors"
+	"testing"
+
+	"github.com/dairyo/j2g/java/util/function"
+)
 
 func TestWrapNoErr(t *testing.T) {
 	if WrapNoErr(nil) != nil {
@@ -10,3 +15,16 @@ func TestWrapNoErr(t *testing.T) {
 		t.Error("must not be nil")
 	}
 }
+
+func TestRecover(t *testing.T) {
+	var pe *function.PanicError
+	if err := Recover(func() error { panic("foo") })(); !errors.As(err, &pe) {
+		t.Fatalf("must be PanicError but %q.", err)
+	}
+	if err := Recover(WrapNoErr(func() {}))(); err != nil {
+		t.Errorf("must not return error: %s", err)
+	}
+	if Recover(nil) != nil {
+		t.Error("must be nil.")
+	}
+}
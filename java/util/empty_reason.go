@@ -0,0 +1,135 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// Op is a kind of operation on [Optional] which may make an Optional
+// empty.
+type Op int
+
+const (
+	OpMap Op = iota + 1
+	OpFlatMap
+	OpFilter
+	OpOr
+	OpZip
+)
+
+func (op Op) String() string {
+	switch op {
+	case OpMap:
+		return "Map"
+	case OpFlatMap:
+		return "FlatMap"
+	case OpFilter:
+		return "Filter"
+	case OpOr:
+		return "Or"
+	case OpZip:
+		return "Zip"
+	default:
+		return fmt.Sprintf("Op(%d)", int(op))
+	}
+}
+
+// EmptyReason is an error returned by [Optional.Error] when an
+// operation in a chain of [Map], [FlatMap], [Optional.Filter],
+// [Optional.Or] and [ZipWith] makes the Optional empty. It can be retrieved with
+// [errors.As].
+//
+// Operations on an already empty Optional, except [Optional.Or], do
+// not create a new EmptyReason but keep the reason of the input, so
+// EmptyReason tells which step emptied the chain.
+type EmptyReason struct {
+	// Op is the operation which made the Optional empty.
+	Op Op
+	// Step is the 1-based index of the operation in the chain. The
+	// Optional created by a constructor is step 0 and each operation
+	// adds one to the step.
+	Step int
+	// Cause is the error why Op made the Optional empty, such as
+	// [ErrPredicateFailed].
+	Cause error
+	// Root is the error which first made the chain empty. Root is
+	// the same as Cause unless Op is [OpOr] which fails to recover
+	// an Optional already empty.
+	Root error
+}
+
+func (r *EmptyReason) Error() string {
+	if r.Root == r.Cause {
+		return fmt.Sprintf("%s at step %d: %v", r.Op, r.Step, r.Cause)
+	}
+	return fmt.Sprintf("%s at step %d: %v (root: %v)", r.Op, r.Step, r.Cause, r.Root)
+}
+
+// Unwrap returns Cause and Root, so that [errors.Is] finds both.
+func (r *EmptyReason) Unwrap() []error {
+	if r.Root == r.Cause {
+		return []error{r.Cause}
+	}
+	return []error{r.Cause, r.Root}
+}
+
+// Is reports whether target is an *EmptyReason with the same Op and
+// Step whose Cause and Root match Cause and Root of r with
+// [errors.Is], so that reasons of Optionals emptied in the same way
+// match.
+func (r *EmptyReason) Is(target error) bool {
+	t, ok := target.(*EmptyReason)
+	if !ok || t == nil {
+		return false
+	}
+	return r.Op == t.Op && r.Step == t.Step &&
+		errors.Is(r.Cause, t.Cause) && errors.Is(r.Root, t.Root)
+}
+
+// sameError reports whether a and b describe the same failure, so
+// that reasons of Optionals emptied in the same way are the same even
+// if their reasons are created for each failure. a and b are the same
+// if they match each other with [errors.Is]. Otherwise only their
+// structured parts are compared:
+//   - *EmptyReason must have the same Op and Step, and the same Cause
+//     and Root.
+//   - errors joined by [errors.Join] must join the same errors in the
+//     same order.
+//   - errors wrapping an error must have the same type and message
+//     and wrap the same error.
+//
+// Any other errors are the same only if they match with errors.Is,
+// so distinct errors with the same message are not the same.
+func sameError(a, b error) bool {
+	if errors.Is(a, b) && errors.Is(b, a) {
+		return true
+	}
+	if a == nil || b == nil || reflect.TypeOf(a) != reflect.TypeOf(b) {
+		return false
+	}
+	switch x := a.(type) {
+	case *EmptyReason:
+		y := b.(*EmptyReason)
+		return x.Op == y.Op && x.Step == y.Step &&
+			sameError(x.Cause, y.Cause) && sameError(x.Root, y.Root)
+	case interface{ Unwrap() []error }:
+		y := b.(interface{ Unwrap() []error })
+		return slices.EqualFunc(x.Unwrap(), y.Unwrap(), sameError)
+	case interface{ Unwrap() error }:
+		y := b.(interface{ Unwrap() error })
+		return a.Error() == b.Error() && sameError(x.Unwrap(), y.Unwrap())
+	}
+	return false
+}
+
+// rootOf returns the root error of err which is a reason of an empty
+// Optional.
+func rootOf(err error) error {
+	var r *EmptyReason
+	if errors.As(err, &r) {
+		return r.Root
+	}
+	return err
+}
@@ -15,4 +15,6 @@ var (
 	ErrNilSupplier     = errors.New("Supplier is nil")
 	ErrSupplierErr     = errors.New("Supplier returns error")
 	ErrNoValue         = errors.New("Method is called for no value Optional")
+	ErrNilValue        = errors.New("Of is called with nil")
+	ErrScan            = errors.New("fail to scan value")
 )
@@ -0,0 +1,117 @@
+package biconsumer
+
+import (
+	"fmt"
+
+	"github.com/dairyo/j2g/java/util/function"
+	"github.com/dairyo/j2g/java/util/function/internal"
+)
+
+/**
+This is a port of java.util.function.BiConsumer.
+
+* https://docs.oracle.com/en/java/javase/21/docs/api/java.base/java/util/function/BiConsumer.html
+* https://github.com/openjdk/jdk/blob/jdk-21%2B35/src/java.base/share/classes/java/util/function/BiConsumer.java
+*/
+
+// BiConsumer is a type to represents a function that accepts two
+// arguments and returns error. Unlike other functional types,
+// BiConsumer is expected to operate via side-effect.
+type BiConsumer[T, U any] func(T, U) error
+
+// WrapNoErr adjusts a function that accepts two arguments and no
+// return to BiConsumer.
+// If f is nil, this function returns nil.
+func WrapNoErr[T, U any](f func(T, U)) BiConsumer[T, U] {
+	if f == nil {
+		return nil
+	}
+	return func(t T, u U) error {
+		f(t, u)
+		return nil
+	}
+}
+
+// Recover returns a BiConsumer which calls c and converts a panic in
+// c to a [*function.PanicError] returned as error.
+// If c is nil, this function returns nil.
+func Recover[T, U any](c BiConsumer[T, U]) BiConsumer[T, U] {
+	if c == nil {
+		return nil
+	}
+	return func(t T, u U) (err error) {
+		defer function.RecoverAsError(&err)
+		return c(t, u)
+	}
+}
+
+// Compose returns a BiConsumer composing arguments.
+//
+// The composed BiConsumer evaluates BiConsumers passed as arguments.
+// The order of evaluating BiConsumers is as the same as the order of
+// arguments. If preceding BiConsumers return error, rest of the
+// BiConsumers are not evaluated. This is a replacement of java
+// BiConsumer's andThen method.
+func Compose[T, U any](c1 BiConsumer[T, U], c2 ...BiConsumer[T, U]) BiConsumer[T, U] {
+	if c1 == nil {
+		return nil
+	}
+	for _, c := range c2 {
+		if c == nil {
+			return nil
+		}
+	}
+	return func(t T, u U) error {
+		if err := c1(t, u); err != nil {
+			return err
+		}
+		for _, c := range c2 {
+			if err := c(t, u); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// Adjust adjusts a function to other function. T1 and T2 are
+// converted to U1 and U2 for the arguments of f.
+//
+// If U1 is an interface, T1 must implements U1. If U1 is a type, T1
+// must be convertible to U1. The same rule is applied to T2 and U2.
+//
+// This function might panic. We recommend you should write adjusting
+// function by your own. See Adjust of package consumer for details.
+func Adjust[T1, U1, T2, U2 any](f func(U1, U2) error) func(T1, T2) error {
+	ret, _ := AdjustE[T1, U1, T2](f)
+	return ret
+}
+
+// AdjustE is the same as [Adjust] but returns an error describing why
+// f can not be adjusted instead of nil. AdjustE returns
+// [function.ErrNilFunctional] if f is nil, and a
+// [*function.CastError] if an argument can not be cast.
+func AdjustE[T1, U1, T2, U2 any](f func(U1, U2) error) (func(T1, T2) error, error) {
+	if f == nil {
+		return nil, function.ErrNilFunctional
+	}
+	cf1, err := internal.CastE[T1, U1]()
+	if err != nil {
+		return nil, fmt.Errorf("fail to adjust first argument: %w", err)
+	}
+	cf2, err := internal.CastE[T2, U2]()
+	if err != nil {
+		return nil, fmt.Errorf("fail to adjust second argument: %w", err)
+	}
+	return func(t1 T1, t2 T2) error {
+		u1, err := cf1(t1)
+		if err != nil {
+			return fmt.Errorf("fail to cast first argument from %T to %T: %w", t1, u1, err)
+		}
+		u2, err := cf2(t2)
+		if err != nil {
+			return fmt.Errorf("fail to cast second argument from %T to %T: %w", t2, u2, err)
+		}
+		return f(u1, u2)
+	}, nil
+}
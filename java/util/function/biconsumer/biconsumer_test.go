@@ -0,0 +1,107 @@
+package biconsumer
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"testing"
+
+	"github.com/dairyo/j2g/java/util/function"
+)
+
+func TestWrapNoErr(t *testing.T) {
+	if WrapNoErr[int, int](nil) != nil {
+		t.Error("must be nil.")
+	}
+	var got int
+	c := WrapNoErr(func(a, b int) { got = a + b })
+	if err := c(1, 2); err != nil {
+		t.Errorf("must not return error but %q.", err)
+	}
+	if got != 3 {
+		t.Errorf("want=%d, got=%d", 3, got)
+	}
+}
+
+func TestCompose(t *testing.T) {
+	var calls []string
+	record := func(name string) BiConsumer[string, int] {
+		return func(k string, v int) error {
+			calls = append(calls, fmt.Sprintf("%s:%s=%d", name, k, v))
+			return nil
+		}
+	}
+	if err := Compose(record("a"), record("b"), record("c"))("k", 1); err != nil {
+		t.Fatalf("must not return error but %q.", err)
+	}
+	want := []string{"a:k=1", "b:k=1", "c:k=1"}
+	if !slices.Equal(calls, want) {
+		t.Errorf("want=%v, got=%v", want, calls)
+	}
+
+	calls = nil
+	e := errors.New("foo")
+	fail := BiConsumer[string, int](func(string, int) error { return e })
+	if err := Compose(record("a"), fail, record("c"))("k", 1); err != e {
+		t.Errorf("want=%q, got=%q", e, err)
+	}
+	if !slices.Equal(calls, []string{"a:k=1"}) {
+		t.Errorf("rest of consumers must not be called: %v", calls)
+	}
+
+	if Compose[string, int](nil) != nil {
+		t.Error("must be nil.")
+	}
+	if Compose(record("a"), nil) != nil {
+		t.Error("must be nil.")
+	}
+}
+
+func TestAdjust(t *testing.T) {
+	f := func(w io.Writer, s fmt.Stringer) error {
+		_, err := io.WriteString(w, s.String())
+		return err
+	}
+	b := &bytes.Buffer{}
+	c := Compose(func(*bytes.Buffer, *bytes.Buffer) error { return nil },
+		Adjust[*bytes.Buffer, io.Writer, *bytes.Buffer, fmt.Stringer](f))
+	if err := c(b, bytes.NewBufferString("foo")); err != nil {
+		t.Fatalf("must not return error but %q.", err)
+	}
+	if b.String() != "foo" {
+		t.Errorf("want=%q, got=%q", "foo", b.String())
+	}
+	if Adjust[int, io.Writer, int, int](func(io.Writer, int) error { return nil }) != nil {
+		t.Error("must be nil.")
+	}
+	if Adjust[int, int, int, int](nil) != nil {
+		t.Error("must be nil.")
+	}
+}
+
+func TestRecover(t *testing.T) {
+	c := Recover(BiConsumer[int, int](func(int, int) error { panic("foo") }))
+	var pe *function.PanicError
+	if err := c(1, 2); !errors.As(err, &pe) {
+		t.Fatalf("must be PanicError but %q.", err)
+	}
+	if Recover(BiConsumer[int, int](nil)) != nil {
+		t.Error("must be nil.")
+	}
+}
+
+func TestAdjustE(t *testing.T) {
+	if _, err := AdjustE[int, int, int, int](nil); !errors.Is(err, function.ErrNilFunctional) {
+		t.Errorf("must be ErrNilFunctional but %v.", err)
+	}
+	_, err := AdjustE[int, int, *bytes.Buffer, io.Closer](func(int, io.Closer) error { return nil })
+	if want := "fail to adjust second argument: *bytes.Buffer does not implement io.Closer"; err == nil || err.Error() != want {
+		t.Errorf("want=%q, got=%v", want, err)
+	}
+	var ce *function.CastError
+	if !errors.As(err, &ce) {
+		t.Errorf("must be CastError but %v.", err)
+	}
+}
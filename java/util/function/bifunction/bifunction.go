@@ -0,0 +1,122 @@
+package bifunction
+
+import (
+	"fmt"
+
+	ufunction "github.com/dairyo/j2g/java/util/function"
+	"github.com/dairyo/j2g/java/util/function/function"
+	"github.com/dairyo/j2g/java/util/function/internal"
+)
+
+/**
+This is a port of java.util.function.BiFunction.
+
+* https://docs.oracle.com/en/java/javase/21/docs/api/java.base/java/util/function/BiFunction.html
+* https://github.com/openjdk/jdk/blob/jdk-21%2B35/src/java.base/share/classes/java/util/function/BiFunction.java
+*/
+
+// BiFunction is a type to represents a function that accepts two
+// arguments and produce one result and error.
+type BiFunction[T, U, R any] func(T, U) (R, error)
+
+// WrapNoErr adjusts a function that accepts two arguments and
+// produce one result to BiFunction.
+// If f is nil, this function returns nil.
+func WrapNoErr[T, U, R any](f func(T, U) R) BiFunction[T, U, R] {
+	if f == nil {
+		return nil
+	}
+	return func(t T, u U) (R, error) { return f(t, u), nil }
+}
+
+// Compose composes a BiFunction and a Function.
+// Returned value from f1 becomes input to f2.
+// Compose returns nil if one of or both of inputted functions are nil.
+// This is a replacement of java BiFunction's andThen method.
+func Compose[T, U, R, V any](f1 BiFunction[T, U, R], f2 function.Function[R, V]) BiFunction[T, U, V] {
+	if f1 == nil {
+		return nil
+	}
+	if f2 == nil {
+		return nil
+	}
+	return func(t T, u U) (V, error) {
+		r, err := f1(t, u)
+		if err != nil {
+			var zero V
+			return zero, err
+		}
+		return f2(r)
+	}
+}
+
+// Recover returns a BiFunction which calls f and converts a panic in
+// f to a [*ufunction.PanicError] returned as error.
+// If f is nil, this function returns nil.
+func Recover[T, U, R any](f BiFunction[T, U, R]) BiFunction[T, U, R] {
+	if f == nil {
+		return nil
+	}
+	return func(t T, u U) (ret R, err error) {
+		defer ufunction.RecoverAsError(&err)
+		return f(t, u)
+	}
+}
+
+// Adjust adjusts a function to other function. T1 and T2 are
+// converted to U1 and U2 for the arguments of f, and U3 returned by f
+// is converted to T3.
+//
+// If U1 is an interface, T1 must implements U1. If U1 is a type, T1
+// must be convertible to U1. The same rule is applied to T2 and U2,
+// and U3 and T3.
+//
+// This function might panic. We recommend you should write adjusting
+// function by your own. See [function.Adjust] for details.
+func Adjust[T1, U1, T2, U2, T3, U3 any](f func(U1, U2) (U3, error)) func(T1, T2) (T3, error) {
+	ret, _ := AdjustE[T1, U1, T2, U2, T3](f)
+	return ret
+}
+
+// AdjustE is the same as [Adjust] but returns an error describing why
+// f can not be adjusted instead of nil. AdjustE returns
+// [ufunction.ErrNilFunctional] if f is nil, and a
+// [*ufunction.CastError] if an argument or the return value can not be
+// cast.
+func AdjustE[T1, U1, T2, U2, T3, U3 any](f func(U1, U2) (U3, error)) (func(T1, T2) (T3, error), error) {
+	if f == nil {
+		return nil, ufunction.ErrNilFunctional
+	}
+	cf1, err := internal.CastE[T1, U1]()
+	if err != nil {
+		return nil, fmt.Errorf("fail to adjust first argument: %w", err)
+	}
+	cf2, err := internal.CastE[T2, U2]()
+	if err != nil {
+		return nil, fmt.Errorf("fail to adjust second argument: %w", err)
+	}
+	cf3, err := internal.CastE[U3, T3]()
+	if err != nil {
+		return nil, fmt.Errorf("fail to adjust return value: %w", err)
+	}
+	return func(t1 T1, t2 T2) (T3, error) {
+		var zero T3
+		u1, err := cf1(t1)
+		if err != nil {
+			return zero, fmt.Errorf("fail to cast first argument from %T to %T: %w", t1, u1, err)
+		}
+		u2, err := cf2(t2)
+		if err != nil {
+			return zero, fmt.Errorf("fail to cast second argument from %T to %T: %w", t2, u2, err)
+		}
+		ret, err := f(u1, u2)
+		if err != nil {
+			return zero, err
+		}
+		t3, err := cf3(ret)
+		if err != nil {
+			return zero, fmt.Errorf("fail to cast return value from %T to %T: %w", ret, t3, err)
+		}
+		return t3, nil
+	}, nil
+}
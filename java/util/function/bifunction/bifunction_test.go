@@ -0,0 +1,104 @@
+package bifunction
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"testing"
+
+	ufunction "github.com/dairyo/j2g/java/util/function"
+	"github.com/dairyo/j2g/java/util/function/function"
+)
+
+func checkBiFunction[T, U, R comparable](t *testing.T, f BiFunction[T, U, R], t1 T, u U, want R) {
+	t.Helper()
+	if f == nil {
+		t.Fatal("must not be nil.")
+	}
+	got, err := f(t1, u)
+	if err != nil {
+		t.Fatalf("must not return error but %q.", err)
+	}
+	if got != want {
+		t.Errorf("want=%v, got=%v", want, got)
+	}
+}
+
+func TestWrapNoErr(t *testing.T) {
+	if WrapNoErr[int, int, int](nil) != nil {
+		t.Error("must be nil.")
+	}
+	checkBiFunction(t, WrapNoErr(func(a, b int) int { return a + b }), 1, 2, 3)
+}
+
+func TestCompose(t *testing.T) {
+	add := WrapNoErr(func(a, b int) int { return a + b })
+	itoa := function.WrapNoErr(strconv.Itoa)
+	checkBiFunction(t, Compose(add, itoa), 1, 2, "3")
+
+	want := errors.New("foo")
+	fail := BiFunction[int, int, int](func(int, int) (int, error) { return 0, want })
+	if _, err := Compose(fail, itoa)(1, 2); err != want {
+		t.Errorf("want=%q, got=%q", want, err)
+	}
+	if Compose[int, int, int, string](nil, itoa) != nil {
+		t.Error("must be nil.")
+	}
+	if Compose[int, int, int, string](add, nil) != nil {
+		t.Error("must be nil.")
+	}
+}
+
+func TestAdjust(t *testing.T) {
+	f := func(w io.Writer, s fmt.Stringer) (io.Writer, error) {
+		_, err := io.WriteString(w, s.String())
+		return w, err
+	}
+	b := &bytes.Buffer{}
+	adjusted := Adjust[*bytes.Buffer, io.Writer, *bytes.Buffer, fmt.Stringer, *bytes.Buffer, io.Writer](f)
+	if adjusted == nil {
+		t.Fatal("must not be nil.")
+	}
+	got, err := adjusted(b, bytes.NewBufferString("foo"))
+	if err != nil {
+		t.Fatalf("must not return error but %q.", err)
+	}
+	if got != b || b.String() != "foo" {
+		t.Errorf("want=%q, got=%q", "foo", b.String())
+	}
+	if Adjust[int, io.Writer, int, int, int, int](func(io.Writer, int) (int, error) { return 0, nil }) != nil {
+		t.Error("must be nil.")
+	}
+	if Adjust[int, int, int, int, int, int](nil) != nil {
+		t.Error("must be nil.")
+	}
+}
+
+func TestRecover(t *testing.T) {
+	f := Recover(BiFunction[int, int, int](func(a, b int) (int, error) { return a / b, nil }))
+	checkBiFunction(t, f, 4, 2, 2)
+	_, err := f(1, 0)
+	var pe *ufunction.PanicError
+	if !errors.As(err, &pe) {
+		t.Fatalf("must be PanicError but %q.", err)
+	}
+	if Recover(BiFunction[int, int, int](nil)) != nil {
+		t.Error("must be nil.")
+	}
+}
+
+func TestAdjustE(t *testing.T) {
+	if _, err := AdjustE[int, int, int, int, int, int](nil); !errors.Is(err, ufunction.ErrNilFunctional) {
+		t.Errorf("must be ErrNilFunctional but %v.", err)
+	}
+	_, err := AdjustE[int, int, *bytes.Buffer, io.Closer, int](func(int, io.Closer) (int, error) { return 0, nil })
+	if want := "fail to adjust second argument: *bytes.Buffer does not implement io.Closer"; err == nil || err.Error() != want {
+		t.Errorf("want=%q, got=%v", want, err)
+	}
+	var ce *ufunction.CastError
+	if !errors.As(err, &ce) {
+		t.Errorf("must be CastError but %v.", err)
+	}
+}
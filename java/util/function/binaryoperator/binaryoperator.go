@@ -0,0 +1,68 @@
+package binaryoperator
+
+import "github.com/dairyo/j2g/java/util/function/bifunction"
+
+/**
+This is a port of java.util.function.BinaryOperator.
+
+* https://docs.oracle.com/en/java/javase/21/docs/api/java.base/java/util/function/BinaryOperator.html
+* https://github.com/openjdk/jdk/blob/jdk-21%2B35/src/java.base/share/classes/java/util/function/BinaryOperator.java
+*/
+
+// BinaryOperator is a type to represents an operation upon two
+// operands of the same type, producing a result of the same type as
+// the operands and error.
+type BinaryOperator[T any] func(T, T) (T, error)
+
+// WrapNoErr adjusts a function that accepts two arguments and
+// produce one result of the same type to BinaryOperator.
+// If f is nil, this function returns nil.
+func WrapNoErr[T any](f func(T, T) T) BinaryOperator[T] {
+	if f == nil {
+		return nil
+	}
+	return func(a, b T) (T, error) { return f(a, b), nil }
+}
+
+// ToBiFunction converts a BinaryOperator to [bifunction.BiFunction].
+// If o is nil, this function returns nil.
+func ToBiFunction[T any](o BinaryOperator[T]) bifunction.BiFunction[T, T, T] {
+	if o == nil {
+		return nil
+	}
+	return bifunction.BiFunction[T, T, T](o)
+}
+
+// MinBy returns a BinaryOperator which returns the lesser of two
+// elements according to cmp. cmp returns a negative number if the
+// first argument is less than the second, zero if they are equal and
+// a positive number otherwise, like cmp.Compare. If the elements
+// are equal, the first one is returned.
+// If cmp is nil, this function returns nil.
+func MinBy[T any](cmp func(T, T) int) BinaryOperator[T] {
+	if cmp == nil {
+		return nil
+	}
+	return func(a, b T) (T, error) {
+		if cmp(a, b) <= 0 {
+			return a, nil
+		}
+		return b, nil
+	}
+}
+
+// MaxBy returns a BinaryOperator which returns the greater of two
+// elements according to cmp. cmp is the same as [MinBy]. If the
+// elements are equal, the first one is returned.
+// If cmp is nil, this function returns nil.
+func MaxBy[T any](cmp func(T, T) int) BinaryOperator[T] {
+	if cmp == nil {
+		return nil
+	}
+	return func(a, b T) (T, error) {
+		if cmp(a, b) >= 0 {
+			return a, nil
+		}
+		return b, nil
+	}
+}
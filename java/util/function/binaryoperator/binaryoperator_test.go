@@ -0,0 +1,68 @@
+package binaryoperator
+
+import (
+	"cmp"
+	"testing"
+)
+
+type item struct {
+	name  string
+	price int
+}
+
+func byPrice(a, b item) int {
+	return cmp.Compare(a.price, b.price)
+}
+
+func checkOperator[T comparable](t *testing.T, o BinaryOperator[T], a, b, want T) {
+	t.Helper()
+	if o == nil {
+		t.Fatal("must not be nil.")
+	}
+	got, err := o(a, b)
+	if err != nil {
+		t.Fatalf("must not return error but %q.", err)
+	}
+	if got != want {
+		t.Errorf("want=%v, got=%v", want, got)
+	}
+}
+
+func TestWrapNoErr(t *testing.T) {
+	if WrapNoErr[int](nil) != nil {
+		t.Error("must be nil.")
+	}
+	checkOperator(t, WrapNoErr(func(a, b int) int { return a * b }), 2, 3, 6)
+}
+
+func TestToBiFunction(t *testing.T) {
+	if ToBiFunction[int](nil) != nil {
+		t.Error("must be nil.")
+	}
+	got, err := ToBiFunction(WrapNoErr(func(a, b int) int { return a - b }))(3, 1)
+	if err != nil || got != 2 {
+		t.Errorf("want=%d, got=%d, %v", 2, got, err)
+	}
+}
+
+func TestMinByMaxBy(t *testing.T) {
+	a := item{"a", 1}
+	b := item{"b", 2}
+	c := item{"c", 1}
+
+	checkOperator(t, MinBy(byPrice), a, b, a)
+	checkOperator(t, MinBy(byPrice), b, a, a)
+	checkOperator(t, MinBy(byPrice), a, c, a)
+	checkOperator(t, MinBy(byPrice), c, a, c)
+
+	checkOperator(t, MaxBy(byPrice), a, b, b)
+	checkOperator(t, MaxBy(byPrice), b, a, b)
+	checkOperator(t, MaxBy(byPrice), a, c, a)
+	checkOperator(t, MaxBy(byPrice), c, a, c)
+
+	checkOperator(t, MinBy(cmp.Compare[int]), 2, 1, 1)
+
+	if MinBy[int](nil) != nil || MaxBy[int](nil) != nil {
+		t.Error("must be nil.")
+	}
+}
@@ -0,0 +1,168 @@
+package bipredicate
+
+import (
+	"fmt"
+
+	"github.com/dairyo/j2g/java/util/function"
+	"github.com/dairyo/j2g/java/util/function/internal"
+)
+
+/**
+This is a port of java.util.function.BiPredicate.
+
+* https://docs.oracle.com/en/java/javase/21/docs/api/java.base/java/util/function/BiPredicate.html
+* https://github.com/openjdk/jdk/blob/jdk-21%2B35/src/java.base/share/classes/java/util/function/BiPredicate.java
+*/
+
+// BiPredicate is a type to represents a function that accepts two
+// arguments and produce one bool result an error.
+type BiPredicate[T, U any] func(T, U) (bool, error)
+
+type biPredicates[T, U any] []BiPredicate[T, U]
+
+func newBiPredicates[T, U any](p1, p2 BiPredicate[T, U], p3 ...BiPredicate[T, U]) biPredicates[T, U] {
+	if p1 == nil {
+		return nil
+	}
+	if p2 == nil {
+		return nil
+	}
+	for _, p := range p3 {
+		if p == nil {
+			return nil
+		}
+	}
+	ret := make(biPredicates[T, U], 0, 2+len(p3))
+	ret = append(ret, p1, p2)
+	ret = append(ret, p3...)
+	return ret
+}
+
+// WrapNoErr adjusts a function that accepts two arguments and
+// produce one bool result to BiPredicate.
+// If f is nil, this function returns nil.
+func WrapNoErr[T, U any](f func(T, U) bool) BiPredicate[T, U] {
+	if f == nil {
+		return nil
+	}
+	return func(t T, u U) (bool, error) { return f(t, u), nil }
+}
+
+// And returns a BiPredicate composed by arguments. The composed
+// BiPredicate is a short-circuiting logical AND. The order of
+// evaluating BiPredicates is as same as the order of arguments of
+// this function. If preceding BiPredicates return false or error,
+// rest of BiPredicates are not evaluated.
+func And[T, U any](p1, p2 BiPredicate[T, U], p3 ...BiPredicate[T, U]) BiPredicate[T, U] {
+	ps := newBiPredicates(p1, p2, p3...)
+	if ps == nil {
+		return nil
+	}
+	return func(t T, u U) (bool, error) {
+		for _, p := range ps {
+			ok, err := p(t, u)
+			if err != nil {
+				return false, err
+			}
+			if !ok {
+				return false, nil
+			}
+		}
+		return true, nil
+	}
+}
+
+// Or returns a BiPredicate composed by arguments. The composed
+// BiPredicate is a short-circuiting logical OR. Order of evaluating
+// BiPredicates is as same as the order of arguments of this
+// function. If preceding BiPredicates return true or error, rest of
+// BiPredicates are not evaluated.
+func Or[T, U any](p1, p2 BiPredicate[T, U], p3 ...BiPredicate[T, U]) BiPredicate[T, U] {
+	ps := newBiPredicates(p1, p2, p3...)
+	if ps == nil {
+		return nil
+	}
+	return func(t T, u U) (bool, error) {
+		for _, p := range ps {
+			ok, err := p(t, u)
+			if err != nil {
+				return false, err
+			}
+			if ok {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+}
+
+// Not returns a BiPredicate which returns negation of the supplied
+// BiPredicate.
+func Not[T, U any](p BiPredicate[T, U]) BiPredicate[T, U] {
+	if p == nil {
+		return nil
+	}
+	return func(t T, u U) (bool, error) {
+		ok, err := p(t, u)
+		if err != nil {
+			return false, err
+		}
+		return !ok, nil
+	}
+}
+
+// Recover returns a BiPredicate which calls p and converts a panic in
+// p to a [*function.PanicError] returned as error.
+// If p is nil, this function returns nil.
+func Recover[T, U any](p BiPredicate[T, U]) BiPredicate[T, U] {
+	if p == nil {
+		return nil
+	}
+	return func(t T, u U) (ok bool, err error) {
+		defer function.RecoverAsError(&err)
+		return p(t, u)
+	}
+}
+
+// Adjust adjusts a function to other function. T1 and T2 are
+// converted to U1 and U2 for the arguments of f.
+//
+// Adjust is mainly used in arguments of [And] and [Or]. If U1 is an
+// interface, T1 must implements U1. If U1 is a type, T1 must be
+// convertible to U1. The same rule is applied to T2 and U2.
+//
+// This function might panic. We recommend you should write adjusting
+// function by your own.
+func Adjust[T1, U1, T2, U2 any](f func(U1, U2) (bool, error)) func(T1, T2) (bool, error) {
+	ret, _ := AdjustE[T1, U1, T2](f)
+	return ret
+}
+
+// AdjustE is the same as [Adjust] but returns an error describing why
+// f can not be adjusted instead of nil. AdjustE returns
+// [function.ErrNilFunctional] if f is nil, and a
+// [*function.CastError] if an argument can not be cast.
+func AdjustE[T1, U1, T2, U2 any](f func(U1, U2) (bool, error)) (func(T1, T2) (bool, error), error) {
+	if f == nil {
+		return nil, function.ErrNilFunctional
+	}
+	cf1, err := internal.CastE[T1, U1]()
+	if err != nil {
+		return nil, fmt.Errorf("fail to adjust first argument: %w", err)
+	}
+	cf2, err := internal.CastE[T2, U2]()
+	if err != nil {
+		return nil, fmt.Errorf("fail to adjust second argument: %w", err)
+	}
+	return func(t1 T1, t2 T2) (bool, error) {
+		u1, err := cf1(t1)
+		if err != nil {
+			return false, fmt.Errorf("fail to cast first argument from %T to %T: %w", t1, u1, err)
+		}
+		u2, err := cf2(t2)
+		if err != nil {
+			return false, fmt.Errorf("fail to cast second argument from %T to %T: %w", t2, u2, err)
+		}
+		return f(u1, u2)
+	}, nil
+}
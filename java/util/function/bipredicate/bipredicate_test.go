@@ -0,0 +1,123 @@
+package bipredicate
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/dairyo/j2g/java/util/function"
+)
+
+func checkBiPredicate[T, U any](t *testing.T, p BiPredicate[T, U], t1 T, u U, want bool) {
+	t.Helper()
+	if p == nil {
+		t.Fatal("must not be nil.")
+	}
+	got, err := p(t1, u)
+	if err != nil {
+		t.Fatalf("must not return error but %q.", err)
+	}
+	if got != want {
+		t.Errorf("must return %t but %t", want, got)
+	}
+}
+
+var (
+	less  = WrapNoErr(func(a, b int) bool { return a < b })
+	equal = WrapNoErr(func(a, b int) bool { return a == b })
+)
+
+func TestWrapNoErr(t *testing.T) {
+	if WrapNoErr[int, int](nil) != nil {
+		t.Error("must be nil.")
+	}
+	checkBiPredicate(t, less, 1, 2, true)
+	checkBiPredicate(t, less, 2, 1, false)
+}
+
+func TestAndOrNot(t *testing.T) {
+	lessOrEqual := Or(less, equal)
+	checkBiPredicate(t, lessOrEqual, 1, 2, true)
+	checkBiPredicate(t, lessOrEqual, 2, 2, true)
+	checkBiPredicate(t, lessOrEqual, 3, 2, false)
+
+	checkBiPredicate(t, And(lessOrEqual, Not(equal)), 1, 2, true)
+	checkBiPredicate(t, And(lessOrEqual, Not(equal)), 2, 2, false)
+	checkBiPredicate(t, And(lessOrEqual, Not(less), equal), 2, 2, true)
+
+	want := errors.New("foo")
+	fail := BiPredicate[int, int](func(int, int) (bool, error) { return false, want })
+	notCalled := BiPredicate[int, int](func(int, int) (bool, error) {
+		t.Error("must not be called.")
+		return false, nil
+	})
+	if _, err := And(fail, notCalled)(1, 2); err != want {
+		t.Errorf("want=%q, got=%q", want, err)
+	}
+	if _, err := Or(fail, notCalled)(1, 2); err != want {
+		t.Errorf("want=%q, got=%q", want, err)
+	}
+	if _, err := Not(fail)(1, 2); err != want {
+		t.Errorf("want=%q, got=%q", want, err)
+	}
+	checkBiPredicate(t, And(less, notCalled), 2, 1, false)
+	checkBiPredicate(t, Or(less, notCalled), 1, 2, true)
+
+	if And(less, nil) != nil || And(nil, less) != nil || And(less, equal, nil) != nil {
+		t.Error("must be nil.")
+	}
+	if Or(less, nil) != nil || Or(nil, less) != nil || Or(less, equal, nil) != nil {
+		t.Error("must be nil.")
+	}
+	if Not[int, int](nil) != nil {
+		t.Error("must be nil.")
+	}
+}
+
+func TestAdjust(t *testing.T) {
+	f := func(w io.Writer, s fmt.Stringer) (bool, error) {
+		_, ok := w.(*bytes.Buffer)
+		return ok && s.String() == "foo", nil
+	}
+	p := And(func(*bytes.Buffer, *bytes.Buffer) (bool, error) { return true, nil },
+		Adjust[*bytes.Buffer, io.Writer, *bytes.Buffer, fmt.Stringer](f))
+	checkBiPredicate(t, p, &bytes.Buffer{}, bytes.NewBufferString("foo"), true)
+	if Adjust[int, io.Writer, int, int](func(io.Writer, int) (bool, error) { return true, nil }) != nil {
+		t.Error("must be nil.")
+	}
+	if Adjust[int, int, int, int](nil) != nil {
+		t.Error("must be nil.")
+	}
+}
+
+func TestRecover(t *testing.T) {
+	p := Recover(BiPredicate[int, int](func(a, b int) (bool, error) { return a/b > 0, nil }))
+	checkBiPredicate(t, p, 2, 1, true)
+	ok, err := p(1, 0)
+	var pe *function.PanicError
+	if !errors.As(err, &pe) {
+		t.Fatalf("must be PanicError but %q.", err)
+	}
+	if ok {
+		t.Error("must return false.")
+	}
+	if Recover(BiPredicate[int, int](nil)) != nil {
+		t.Error("must be nil.")
+	}
+}
+
+func TestAdjustE(t *testing.T) {
+	if _, err := AdjustE[int, int, int, int](nil); !errors.Is(err, function.ErrNilFunctional) {
+		t.Errorf("must be ErrNilFunctional but %v.", err)
+	}
+	_, err := AdjustE[int, int, *bytes.Buffer, io.Closer](func(int, io.Closer) (bool, error) { return true, nil })
+	if want := "fail to adjust second argument: *bytes.Buffer does not implement io.Closer"; err == nil || err.Error() != want {
+		t.Errorf("want=%q, got=%v", want, err)
+	}
+	var ce *function.CastError
+	if !errors.As(err, &ce) {
+		t.Errorf("must be CastError but %v.", err)
+	}
+}
@@ -3,6 +3,7 @@ package consumer
 import (
 	"fmt"
 
+	"github.com/dairyo/j2g/java/util/function"
 	"github.com/dairyo/j2g/java/util/function/internal"
 )
 
@@ -31,6 +32,19 @@ func WrapNoErr[T any](f func(in T)) Consumer[T] {
 	}
 }
 
+// Recover returns a Consumer which calls c and converts a panic in c
+// to a [*function.PanicError] returned as error.
+// If c is nil, this function returns nil.
+func Recover[T any](c Consumer[T]) Consumer[T] {
+	if c == nil {
+		return nil
+	}
+	return func(in T) (err error) {
+		defer function.RecoverAsError(&err)
+		return c(in)
+	}
+}
+
 // Compose returns a Consumer composing arguments.
 //
 // The composed Consumer evaluates Consumers passed as arguments. The
@@ -91,12 +105,21 @@ func Compose[T any](c1 Consumer[T], c2 ...Consumer[T]) Consumer[T] {
 //	b := &bytes.Buffer{}
 //	Compose[*bytes.Buffer](f1, func(in *bytes.Buffer) error { return f2(in) })(b)
 func Adjust[T, U any](f func(U) error) func(T) error {
+	ret, _ := AdjustE[T](f)
+	return ret
+}
+
+// AdjustE is the same as [Adjust] but returns an error describing why
+// f can not be adjusted instead of nil. AdjustE returns
+// [function.ErrNilFunctional] if f is nil, and a
+// [*function.CastError] if T can not be cast to U.
+func AdjustE[T, U any](f func(U) error) (func(T) error, error) {
 	if f == nil {
-		return nil
+		return nil, function.ErrNilFunctional
 	}
-	cf := internal.Cast[T, U]()
-	if cf == nil {
-		return nil
+	cf, err := internal.CastE[T, U]()
+	if err != nil {
+		return nil, err
 	}
 	return func(in T) error {
 		u, err := cf(in)
@@ -104,5 +127,5 @@ func Adjust[T, U any](f func(U) error) func(T) error {
 			return fmt.Errorf("fail to cast from %T to %T: %w", in, u, err)
 		}
 		return f(u)
-	}
+	}, nil
 }
@@ -2,7 +2,11 @@ package consumer
 
 import (
 	"bytes"
+	"errors"
+	"io"
 	"testing"
+
+	"github.com/dairyo/j2g/java/util/function"
 )
 
 func TestWrapNoErr(t *testing.T) {
@@ -111,3 +115,49 @@ func TestCast(t *testing.T) {
 		return
 	}
 }
+
+func TestRecover(t *testing.T) {
+	c := Recover(func(i int) error {
+		if i == 0 {
+			panic("zero")
+		}
+		return nil
+	})
+	if err := c(1); err != nil {
+		t.Errorf("must not return error: %s", err)
+	}
+	var pe *function.PanicError
+	if err := c(0); !errors.As(err, &pe) {
+		t.Fatalf("must be PanicError but %q.", err)
+	}
+	if Recover(Consumer[int](nil)) != nil {
+		t.Error("must be nil.")
+	}
+}
+
+func TestAdjustE(t *testing.T) {
+	if _, err := AdjustE[any, any](nil); !errors.Is(err, function.ErrNilFunctional) {
+		t.Errorf("must be ErrNilFunctional but %v.", err)
+	}
+	_, err := AdjustE[*bytes.Buffer](func(io.Closer) error { return nil })
+	var ce *function.CastError
+	if !errors.As(err, &ce) {
+		t.Fatalf("must be CastError but %v.", err)
+	}
+	if want := "*bytes.Buffer does not implement io.Closer"; err.Error() != want {
+		t.Errorf("want=%q, got=%q", want, err.Error())
+	}
+
+	f, err := AdjustE[io.Writer](func(b *bytes.Buffer) error {
+		if b != nil {
+			t.Errorf("must be nil but %v.", b)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("must not return err: %s", err)
+	}
+	if err := f(nil); err != nil {
+		t.Errorf("must not return err: %s", err)
+	}
+}
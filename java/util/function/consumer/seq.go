@@ -0,0 +1,47 @@
+package consumer
+
+import (
+	"iter"
+
+	"github.com/dairyo/j2g/java/util/function"
+)
+
+// ForEachSeq calls c with each element of seq in order. If c returns
+// error, ForEachSeq stops and returns the error. ForEachSeq returns
+// [function.ErrNilFunctional] if c is nil. Nil seq is treated as an
+// empty sequence.
+func ForEachSeq[T any](seq iter.Seq[T], c Consumer[T]) error {
+	if c == nil {
+		return function.ErrNilFunctional
+	}
+	if seq == nil {
+		return nil
+	}
+	for in := range seq {
+		if err := c(in); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ForEachSeq2 is the same as [ForEachSeq] but seq yields each element
+// with an error. If seq yields an error, ForEachSeq2 stops and
+// returns the error without calling c.
+func ForEachSeq2[T any](seq iter.Seq2[T, error], c Consumer[T]) error {
+	if c == nil {
+		return function.ErrNilFunctional
+	}
+	if seq == nil {
+		return nil
+	}
+	for in, err := range seq {
+		if err != nil {
+			return err
+		}
+		if err := c(in); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package consumer
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/dairyo/j2g/java/util/function"
+)
+
+func TestForEachSeq(t *testing.T) {
+	var got []int
+	c := WrapNoErr(func(i int) { got = append(got, i) })
+	if err := ForEachSeq(slices.Values([]int{1, 2, 3}), c); err != nil {
+		t.Fatalf("must not return error: %s", err)
+	}
+	if !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("want=[1 2 3], got=%v", got)
+	}
+
+	want := errors.New("foo")
+	called := 0
+	err := ForEachSeq(slices.Values([]int{1, 2, 3}), func(i int) error {
+		called++
+		if i == 2 {
+			return want
+		}
+		return nil
+	})
+	if err != want {
+		t.Errorf("want=%q, got=%q", want, err)
+	}
+	if called != 2 {
+		t.Errorf("want=2, got=%d", called)
+	}
+
+	if err := ForEachSeq(slices.Values([]int{1}), nil); err != function.ErrNilFunctional {
+		t.Errorf("want=%q, got=%q", function.ErrNilFunctional, err)
+	}
+	if err := ForEachSeq(nil, c); err != nil {
+		t.Errorf("must not return error: %s", err)
+	}
+}
+
+func TestForEachSeq2(t *testing.T) {
+	want := errors.New("foo")
+	seq := func(yield func(int, error) bool) {
+		_ = yield(1, nil) && yield(0, want) && yield(3, nil)
+	}
+	var got []int
+	err := ForEachSeq2(seq, WrapNoErr(func(i int) { got = append(got, i) }))
+	if err != want {
+		t.Errorf("want=%q, got=%q", want, err)
+	}
+	if !slices.Equal(got, []int{1}) {
+		t.Errorf("want=[1], got=%v", got)
+	}
+
+	sum := 0
+	noErr := func(yield func(int, error) bool) {
+		_ = yield(1, nil) && yield(2, nil)
+	}
+	if err := ForEachSeq2(noErr, WrapNoErr(func(i int) { sum += i })); err != nil {
+		t.Fatalf("must not return error: %s", err)
+	}
+	if sum != 3 {
+		t.Errorf("want=3, got=%d", sum)
+	}
+
+	if err := ForEachSeq2(seq, nil); err != function.ErrNilFunctional {
+		t.Errorf("want=%q, got=%q", function.ErrNilFunctional, err)
+	}
+}
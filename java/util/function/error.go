@@ -1,7 +1,30 @@
 package function
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
 
 var (
-	ErrFailToCast = errors.New("fail to cast")
+	ErrFailToCast    = errors.New("fail to cast")
+	ErrNilFunctional = errors.New("functional object is nil")
 )
+
+// CastError is an error returned when a value of From type can not
+// be cast to To type.
+type CastError struct {
+	From, To reflect.Type
+}
+
+func (e *CastError) Error() string {
+	if e.To.Kind() == reflect.Interface {
+		return fmt.Sprintf("%v does not implement %v", e.From, e.To)
+	}
+	return fmt.Sprintf("%v is not convertible to %v", e.From, e.To)
+}
+
+// Unwrap returns [ErrFailToCast].
+func (e *CastError) Unwrap() error {
+	return ErrFailToCast
+}
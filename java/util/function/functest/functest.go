@@ -0,0 +1,192 @@
+// Package functest provides spies of functional types for tests.
+//
+// A spy wraps a functional object, calls it and records the calls.
+// If the wrapped object is nil, the spy returns zero values and nil
+// error. Spies sharing a [Recorder] also record the order of calls
+// among them. Spies are safe for concurrent use.
+package functest
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/dairyo/j2g/java/lang/runnable"
+	"github.com/dairyo/j2g/java/util/function/consumer"
+	"github.com/dairyo/j2g/java/util/function/function"
+	"github.com/dairyo/j2g/java/util/function/predicate"
+	"github.com/dairyo/j2g/java/util/function/supplier"
+)
+
+// Recorder records the order of calls of spies.
+type Recorder struct {
+	mu    sync.Mutex
+	names []string
+}
+
+// Calls returns the names of spies in the order they are called.
+func (r *Recorder) Calls() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.names)
+}
+
+func (r *Recorder) record(name string) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.names = append(r.names, name)
+}
+
+// Spy records calls with arguments of type A. Spies of functional
+// types accepting no argument record struct{}.
+type Spy[A any] struct {
+	name string
+	rec  *Recorder
+	mu   sync.Mutex
+	args []A
+}
+
+func (s *Spy[A]) record(a A) {
+	s.mu.Lock()
+	s.args = append(s.args, a)
+	s.mu.Unlock()
+	s.rec.record(s.name)
+}
+
+// Name returns the name of this spy.
+func (s *Spy[A]) Name() string {
+	return s.name
+}
+
+// Count returns the number of calls.
+func (s *Spy[A]) Count() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.args)
+}
+
+// Called returns true if this spy is called at least once.
+func (s *Spy[A]) Called() bool {
+	return s.Count() > 0
+}
+
+// Args returns the arguments of calls in the order they are called.
+func (s *Spy[A]) Args() []A {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.args)
+}
+
+// ConsumerSpy is a spy of [consumer.Consumer].
+type ConsumerSpy[T any] struct {
+	Spy[T]
+	c consumer.Consumer[T]
+}
+
+// SpyConsumer returns a spy of c named name. rec may be nil.
+func SpyConsumer[T any](rec *Recorder, name string, c consumer.Consumer[T]) *ConsumerSpy[T] {
+	return &ConsumerSpy[T]{Spy: Spy[T]{name: name, rec: rec}, c: c}
+}
+
+// Consumer returns a [consumer.Consumer] which records calls.
+func (s *ConsumerSpy[T]) Consumer() consumer.Consumer[T] {
+	return func(in T) error {
+		s.record(in)
+		if s.c == nil {
+			return nil
+		}
+		return s.c(in)
+	}
+}
+
+// SupplierSpy is a spy of [supplier.Supplier].
+type SupplierSpy[T any] struct {
+	Spy[struct{}]
+	s supplier.Supplier[T]
+}
+
+// SpySupplier returns a spy of s named name. rec may be nil.
+func SpySupplier[T any](rec *Recorder, name string, s supplier.Supplier[T]) *SupplierSpy[T] {
+	return &SupplierSpy[T]{Spy: Spy[struct{}]{name: name, rec: rec}, s: s}
+}
+
+// Supplier returns a [supplier.Supplier] which records calls.
+func (s *SupplierSpy[T]) Supplier() supplier.Supplier[T] {
+	return func() (T, error) {
+		s.record(struct{}{})
+		if s.s == nil {
+			var zero T
+			return zero, nil
+		}
+		return s.s()
+	}
+}
+
+// RunnableSpy is a spy of [runnable.Runnable].
+type RunnableSpy struct {
+	Spy[struct{}]
+	r runnable.Runnable
+}
+
+// SpyRunnable returns a spy of r named name. rec may be nil.
+func SpyRunnable(rec *Recorder, name string, r runnable.Runnable) *RunnableSpy {
+	return &RunnableSpy{Spy: Spy[struct{}]{name: name, rec: rec}, r: r}
+}
+
+// Runnable returns a [runnable.Runnable] which records calls.
+func (s *RunnableSpy) Runnable() runnable.Runnable {
+	return func() error {
+		s.record(struct{}{})
+		if s.r == nil {
+			return nil
+		}
+		return s.r()
+	}
+}
+
+// PredicateSpy is a spy of [predicate.Predicate].
+type PredicateSpy[T any] struct {
+	Spy[T]
+	p predicate.Predicate[T]
+}
+
+// SpyPredicate returns a spy of p named name. rec may be nil.
+func SpyPredicate[T any](rec *Recorder, name string, p predicate.Predicate[T]) *PredicateSpy[T] {
+	return &PredicateSpy[T]{Spy: Spy[T]{name: name, rec: rec}, p: p}
+}
+
+// Predicate returns a [predicate.Predicate] which records calls.
+func (s *PredicateSpy[T]) Predicate() predicate.Predicate[T] {
+	return func(in T) (bool, error) {
+		s.record(in)
+		if s.p == nil {
+			return false, nil
+		}
+		return s.p(in)
+	}
+}
+
+// FunctionSpy is a spy of [function.Function].
+type FunctionSpy[T, U any] struct {
+	Spy[T]
+	f function.Function[T, U]
+}
+
+// SpyFunction returns a spy of f named name. rec may be nil.
+func SpyFunction[T, U any](rec *Recorder, name string, f function.Function[T, U]) *FunctionSpy[T, U] {
+	return &FunctionSpy[T, U]{Spy: Spy[T]{name: name, rec: rec}, f: f}
+}
+
+// Function returns a [function.Function] which records calls.
+func (s *FunctionSpy[T, U]) Function() function.Function[T, U] {
+	return func(in T) (U, error) {
+		s.record(in)
+		if s.f == nil {
+			var zero U
+			return zero, nil
+		}
+		return s.f(in)
+	}
+}
@@ -0,0 +1,73 @@
+package functest
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestSpies(t *testing.T) {
+	rec := &Recorder{}
+	want := errors.New("foo")
+	c := SpyConsumer(rec, "consumer", func(int) error { return want })
+	s := SpySupplier(rec, "supplier", func() (int, error) { return 2, nil })
+	r := SpyRunnable(rec, "runnable", nil)
+	p := SpyPredicate(rec, "predicate", func(i int) (bool, error) { return i > 1, nil })
+	f := SpyFunction(rec, "function", func(i int) (string, error) { return "x", nil })
+
+	if c.Called() {
+		t.Error("must not be called.")
+	}
+	if err := c.Consumer()(1); err != want {
+		t.Errorf("want=%q, got=%q", want, err)
+	}
+	if v, err := s.Supplier()(); v != 2 || err != nil {
+		t.Errorf("want=2, got=%d, %v", v, err)
+	}
+	if err := r.Runnable()(); err != nil {
+		t.Errorf("must not return error but %q.", err)
+	}
+	if ok, _ := p.Predicate()(2); !ok {
+		t.Error("must return the result of the predicate.")
+	}
+	if v, _ := f.Function()(3); v != "x" {
+		t.Errorf("want=%q, got=%q", "x", v)
+	}
+	c.Consumer()(4)
+
+	if got := c.Args(); !slices.Equal(got, []int{1, 4}) {
+		t.Errorf("want=%v, got=%v", []int{1, 4}, got)
+	}
+	if !c.Called() || c.Count() != 2 || s.Count() != 1 || r.Count() != 1 {
+		t.Errorf("wrong counts: %d, %d, %d", c.Count(), s.Count(), r.Count())
+	}
+	if got := p.Args(); !slices.Equal(got, []int{2}) {
+		t.Errorf("want=%v, got=%v", []int{2}, got)
+	}
+	wantCalls := []string{"consumer", "supplier", "runnable", "predicate", "function", "consumer"}
+	if got := rec.Calls(); !slices.Equal(got, wantCalls) {
+		t.Errorf("want=%v, got=%v", wantCalls, got)
+	}
+}
+
+func TestNilSpies(t *testing.T) {
+	c := SpyConsumer[int](nil, "c", nil)
+	if err := c.Consumer()(1); err != nil {
+		t.Errorf("must not return error but %q.", err)
+	}
+	s := SpySupplier[int](nil, "s", nil)
+	if v, err := s.Supplier()(); v != 0 || err != nil {
+		t.Errorf("want=0, got=%d, %v", v, err)
+	}
+	p := SpyPredicate[int](nil, "p", nil)
+	if ok, err := p.Predicate()(1); ok || err != nil {
+		t.Errorf("want=false, got=%t, %v", ok, err)
+	}
+	f := SpyFunction[int, int](nil, "f", nil)
+	if v, err := f.Function()(1); v != 0 || err != nil {
+		t.Errorf("want=0, got=%d, %v", v, err)
+	}
+	if c.Name() != "c" || c.Count() != 1 {
+		t.Errorf("wrong spy: %s, %d", c.Name(), c.Count())
+	}
+}
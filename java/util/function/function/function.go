@@ -1,8 +1,10 @@
 package function
 
 import (
+	"context"
 	"fmt"
 
+	ufunction "github.com/dairyo/j2g/java/util/function"
 	"github.com/dairyo/j2g/java/util/function/internal"
 )
 
@@ -27,6 +29,29 @@ func WrapNoErr[T any, U any](f func(in T) U) Function[T, U] {
 	return func(in T) (U, error) { return f(in), nil }
 }
 
+// FunctionCtx is a type to represents a function that accepts a
+// [context.Context] and one argument and produce one result and
+// error.
+type FunctionCtx[T any, U any] func(context.Context, T) (U, error)
+
+// WithContext adjusts a Function to FunctionCtx ignoring the context.
+// If f is nil, this function returns nil.
+func WithContext[T any, U any](f Function[T, U]) FunctionCtx[T, U] {
+	if f == nil {
+		return nil
+	}
+	return func(_ context.Context, in T) (U, error) { return f(in) }
+}
+
+// Bind returns a Function which calls f with ctx.
+// If f is nil, this function returns nil.
+func Bind[T any, U any](ctx context.Context, f FunctionCtx[T, U]) Function[T, U] {
+	if f == nil {
+		return nil
+	}
+	return func(in T) (U, error) { return f(ctx, in) }
+}
+
 // Compose composes two functions.
 // Returned value from first function becomes input to second function.
 // Compose returns nil if one of or both of inputted functions are nil.
@@ -48,6 +73,19 @@ func Compose[T any, U any, V any](f1 Function[T, U], f2 Function[U, V]) Function
 	})
 }
 
+// Recover returns a Function which calls f and converts a panic in f
+// to a [*ufunction.PanicError] returned as error.
+// If f is nil, this function returns nil.
+func Recover[T any, U any](f Function[T, U]) Function[T, U] {
+	if f == nil {
+		return nil
+	}
+	return func(in T) (ret U, err error) {
+		defer ufunction.RecoverAsError(&err)
+		return f(in)
+	}
+}
+
 // Identity generate a function which always returns its input argument.
 func Identity[T any]() Function[T, T] {
 	return func(in T) (T, error) { return in, nil }
@@ -95,16 +133,26 @@ func Identity[T any]() Function[T, T] {
 //  		},
 //  	)(b)
 func Adjust[T1, U1, T2, U2 any](f func(U1) (U2, error)) func(T1) (T2, error) {
+	ret, _ := AdjustE[T1, U1, T2](f)
+	return ret
+}
+
+// AdjustE is the same as [Adjust] but returns an error describing why
+// f can not be adjusted instead of nil. AdjustE returns
+// [ufunction.ErrNilFunctional] if f is nil, and a
+// [*ufunction.CastError] if T1 can not be cast to U1 or U2 can not be
+// cast to T2.
+func AdjustE[T1, U1, T2, U2 any](f func(U1) (U2, error)) (func(T1) (T2, error), error) {
 	if f == nil {
-		return nil
+		return nil, ufunction.ErrNilFunctional
 	}
-	cf1 := internal.Cast[T1, U1]()
-	if cf1 == nil {
-		return nil
+	cf1, err := internal.CastE[T1, U1]()
+	if err != nil {
+		return nil, fmt.Errorf("fail to adjust argument: %w", err)
 	}
-	cf2 := internal.Cast[U2, T2]()
-	if cf2 == nil {
-		return nil
+	cf2, err := internal.CastE[U2, T2]()
+	if err != nil {
+		return nil, fmt.Errorf("fail to adjust return value: %w", err)
 	}
 	return func(in T1) (T2, error) {
 		u1, err := cf1(in)
@@ -123,5 +171,5 @@ func Adjust[T1, U1, T2, U2 any](f func(U1) (U2, error)) func(T1) (T2, error) {
 			return zero, fmt.Errorf("fail to cast return value from %T to %T: %w", ret, u2, err)
 		}
 		return u2, nil
-	}
+	}, nil
 }
@@ -2,10 +2,13 @@ package function
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"io"
 	"strconv"
 	"testing"
+
+	ufunction "github.com/dairyo/j2g/java/util/function"
 )
 
 func c[T any, U any, V any](f1 Function[T, U], f2 Function[U, V]) Function[T, V] {
@@ -141,3 +144,67 @@ func TestAdjust(t *testing.T) {
 		t.Fatal("must be same.")
 	}
 }
+
+func TestContext(t *testing.T) {
+	type key struct{}
+	ctx := context.WithValue(context.Background(), key{}, 1)
+
+	f1 := WithContext(wne(strconv.Itoa))
+	got, err := f1(ctx, 1)
+	if err != nil || got != "1" {
+		t.Errorf("want=(1, nil), got=(%s, %v)", got, err)
+	}
+
+	f2 := Bind(ctx, func(ctx context.Context, i int) (int, error) { return i + ctx.Value(key{}).(int), nil })
+	checkFunction(t, f2, 1, 2)
+
+	if WithContext(Function[int, int](nil)) != nil {
+		t.Error("must be nil.")
+	}
+	if Bind(ctx, FunctionCtx[int, int](nil)) != nil {
+		t.Error("must be nil.")
+	}
+}
+
+func TestRecover(t *testing.T) {
+	f := Recover(func(i int) (int, error) {
+		if i == 0 {
+			panic("zero")
+		}
+		return i, nil
+	})
+	checkFunction(t, f, 1, 1)
+	got, err := f(0)
+	var pe *ufunction.PanicError
+	if !errors.As(err, &pe) {
+		t.Fatalf("must be PanicError but %q.", err)
+	}
+	if pe.Value != "zero" || got != 0 {
+		t.Errorf("want=(0, zero), got=(%d, %v)", got, pe.Value)
+	}
+	if Recover(Function[int, int](nil)) != nil {
+		t.Error("must be nil.")
+	}
+}
+
+func TestAdjustE(t *testing.T) {
+	if _, err := AdjustE[any, any, any, any](nil); !errors.Is(err, ufunction.ErrNilFunctional) {
+		t.Errorf("must be ErrNilFunctional but %v.", err)
+	}
+	_, err := AdjustE[*bytes.Buffer, io.Closer, int](func(io.Closer) (int, error) { return 0, nil })
+	if want := "fail to adjust argument: *bytes.Buffer does not implement io.Closer"; err == nil || err.Error() != want {
+		t.Errorf("want=%q, got=%v", want, err)
+	}
+	_, err = AdjustE[int, int, *bytes.Buffer](func(int) (int, error) { return 0, nil })
+	if !errors.Is(err, ufunction.ErrFailToCast) {
+		t.Errorf("must be ErrFailToCast but %v.", err)
+	}
+	f, err := AdjustE[*bytes.Buffer, io.Writer, io.Writer](func(w io.Writer) (*bytes.Buffer, error) {
+		return w.(*bytes.Buffer), nil
+	})
+	if err != nil {
+		t.Fatalf("must not return err: %s", err)
+	}
+	b := &bytes.Buffer{}
+	checkFunction(t, f, b, io.Writer(b))
+}
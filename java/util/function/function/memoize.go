@@ -0,0 +1,249 @@
+package function
+
+import (
+	"container/list"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	ufunction "github.com/dairyo/j2g/java/util/function"
+)
+
+// MemoizeOption configures the cache policy of [Memoize].
+type MemoizeOption func(*memoConfig)
+
+type memoConfig struct {
+	maxSize     int
+	ttl         time.Duration
+	now         func() time.Time
+	cacheErrors bool
+}
+
+// MemoLRU limits the number of cached results to maxSize. When the
+// cache is full, the least recently used result is evicted. If
+// maxSize is not positive, the cache is unbounded, which is the
+// default.
+func MemoLRU(maxSize int) MemoizeOption {
+	return func(c *memoConfig) { c.maxSize = maxSize }
+}
+
+// MemoTTL makes cached results expire after ttl. Expired results are
+// removed when a result is cached, so they do not stay in the cache.
+// If ttl is not positive, cached results never expire, which is the
+// default.
+func MemoTTL(ttl time.Duration) MemoizeOption {
+	return func(c *memoConfig) { c.ttl = ttl }
+}
+
+// MemoClock sets the clock used for [MemoTTL]. The default is
+// [time.Now]. If now is nil, the default is used.
+func MemoClock(now func() time.Time) MemoizeOption {
+	return func(c *memoConfig) {
+		if now != nil {
+			c.now = now
+		}
+	}
+}
+
+// MemoCacheErrors makes errors returned by the memoized Function
+// cached as well as results. By default, errors are not cached and
+// the next call for the same key calls the Function again.
+func MemoCacheErrors() MemoizeOption {
+	return func(c *memoConfig) { c.cacheErrors = true }
+}
+
+// MemoStats is statistics of a [Memoized].
+type MemoStats struct {
+	// Hits is the number of calls answered without calling the
+	// memoized Function, including calls which wait for a
+	// concurrent call for the same key.
+	Hits uint64
+	// Misses is the number of calls of the memoized Function.
+	Misses uint64
+	// Evictions is the number of cached results removed by
+	// [MemoLRU] or [MemoTTL].
+	Evictions uint64
+	// Size is the number of cached results which are not expired.
+	Size int
+}
+
+type memoEntry[K comparable, V any] struct {
+	key     K
+	val     V
+	err     error
+	expires time.Time
+	// byExpiry is the element of the entry in Memoized.expiry.
+	byExpiry *list.Element
+}
+
+type memoCall[V any] struct {
+	wg  sync.WaitGroup
+	val V
+	err error
+}
+
+// Memoized is a [Function] caching its results. It is safe for
+// concurrent use. Concurrent calls for the same key which is not
+// cached collapse into one call of the Function.
+type Memoized[K comparable, V any] struct {
+	f   Function[K, V]
+	cfg memoConfig
+
+	mu      sync.Mutex
+	entries map[K]*list.Element
+	// order holds *memoEntry from the most recently used one.
+	order *list.List
+	// expiry holds *memoEntry from the oldest one, which expires
+	// first, if MemoTTL is set.
+	expiry *list.List
+	calls map[K]*memoCall[V]
+	stats MemoStats
+}
+
+// Memoize returns a [Memoized] caching the results of f by its
+// argument. The cache is unbounded and the results never expire
+// unless opts configure it. f should be a pure function.
+// If f is nil, this function returns nil.
+func Memoize[K comparable, V any](f Function[K, V], opts ...MemoizeOption) *Memoized[K, V] {
+	if f == nil {
+		return nil
+	}
+	cfg := memoConfig{now: time.Now}
+	for _, o := range opts {
+		o(&cfg)
+	}
+	return &Memoized[K, V]{
+		f:       f,
+		cfg:     cfg,
+		entries: make(map[K]*list.Element),
+		order:   list.New(),
+		expiry:  list.New(),
+		calls:   make(map[K]*memoCall[V]),
+	}
+}
+
+// Function returns the [Function] calling [Memoized.Apply].
+// If m is nil, this function returns nil.
+func (m *Memoized[K, V]) Function() Function[K, V] {
+	if m == nil {
+		return nil
+	}
+	return m.Apply
+}
+
+// Stats returns the current statistics.
+func (m *Memoized[K, V]) Stats() MemoStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expire()
+	s := m.stats
+	s.Size = m.order.Len()
+	return s
+}
+
+// Apply returns the cached result for k. If the result is not cached,
+// Apply calls the memoized Function and caches the result. If the
+// Function panics, the panic is propagated to the caller and
+// concurrent callers waiting for it get a [*ufunction.PanicError].
+func (m *Memoized[K, V]) Apply(k K) (V, error) {
+	m.mu.Lock()
+	if e, ok := m.lookup(k); ok {
+		m.stats.Hits++
+		m.mu.Unlock()
+		return e.val, e.err
+	}
+	if c, ok := m.calls[k]; ok {
+		m.stats.Hits++
+		m.mu.Unlock()
+		c.wg.Wait()
+		return c.val, c.err
+	}
+	c := &memoCall[V]{}
+	c.wg.Add(1)
+	m.calls[k] = c
+	m.stats.Misses++
+	m.mu.Unlock()
+
+	m.call(k, c)
+	return c.val, c.err
+}
+
+func (m *Memoized[K, V]) call(k K, c *memoCall[V]) {
+	defer func() {
+		if v := recover(); v != nil {
+			c.err = &ufunction.PanicError{Value: v, Stack: debug.Stack()}
+			m.finish(k, c, false)
+			panic(v)
+		}
+	}()
+	c.val, c.err = m.f(k)
+	m.finish(k, c, c.err == nil || m.cfg.cacheErrors)
+}
+
+// finish caches the result of c if store is true and wakes callers
+// waiting for c.
+func (m *Memoized[K, V]) finish(k K, c *memoCall[V], store bool) {
+	m.mu.Lock()
+	delete(m.calls, k)
+	if store {
+		m.store(&memoEntry[K, V]{key: k, val: c.val, err: c.err})
+	}
+	m.mu.Unlock()
+	c.wg.Done()
+}
+
+// lookup returns the cached entry for k. m.mu must be held.
+func (m *Memoized[K, V]) lookup(k K) (*memoEntry[K, V], bool) {
+	el, ok := m.entries[k]
+	if !ok {
+		return nil, false
+	}
+	e := el.Value.(*memoEntry[K, V])
+	if m.cfg.ttl > 0 && !m.cfg.now().Before(e.expires) {
+		m.remove(el)
+		return nil, false
+	}
+	m.order.MoveToFront(el)
+	return e, true
+}
+
+// store caches e and evicts expired entries and the least recently
+// used entry if the cache is full. m.mu must be held.
+func (m *Memoized[K, V]) store(e *memoEntry[K, V]) {
+	m.expire()
+	if m.cfg.ttl > 0 {
+		e.expires = m.cfg.now().Add(m.cfg.ttl)
+		e.byExpiry = m.expiry.PushBack(e)
+	}
+	m.entries[e.key] = m.order.PushFront(e)
+	if m.cfg.maxSize > 0 && m.order.Len() > m.cfg.maxSize {
+		m.remove(m.order.Back())
+	}
+}
+
+// expire evicts expired entries, so that entries which are never
+// looked up again do not stay in the cache. m.mu must be held.
+func (m *Memoized[K, V]) expire() {
+	if m.cfg.ttl <= 0 {
+		return
+	}
+	now := m.cfg.now()
+	for el := m.expiry.Front(); el != nil; el = m.expiry.Front() {
+		e := el.Value.(*memoEntry[K, V])
+		if now.Before(e.expires) {
+			return
+		}
+		m.remove(m.entries[e.key])
+	}
+}
+
+// remove evicts the entry of el. m.mu must be held.
+func (m *Memoized[K, V]) remove(el *list.Element) {
+	e := el.Value.(*memoEntry[K, V])
+	m.order.Remove(el)
+	if e.byExpiry != nil {
+		m.expiry.Remove(e.byExpiry)
+	}
+	delete(m.entries, e.key)
+	m.stats.Evictions++
+}
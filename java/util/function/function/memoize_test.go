@@ -0,0 +1,200 @@
+package function
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	ufunction "github.com/dairyo/j2g/java/util/function"
+)
+
+// countingFunction returns a Function doubling its input and counts
+// calls by key.
+func countingFunction() (Function[int, int], map[int]int) {
+	calls := map[int]int{}
+	return func(k int) (int, error) {
+		calls[k]++
+		return k * 2, nil
+	}, calls
+}
+
+func checkApply(t *testing.T, m *Memoized[int, int], k, want int) {
+	t.Helper()
+	got, err := m.Apply(k)
+	if err != nil {
+		t.Fatalf("must not return error but %q.", err)
+	}
+	if got != want {
+		t.Errorf("want=%d, got=%d", want, got)
+	}
+}
+
+func checkStats(t *testing.T, m *Memoized[int, int], want MemoStats) {
+	t.Helper()
+	if got := m.Stats(); got != want {
+		t.Errorf("want=%+v, got=%+v", want, got)
+	}
+}
+
+func TestMemoize(t *testing.T) {
+	if Memoize[int, int](nil) != nil || Memoize[int, int](nil).Function() != nil {
+		t.Error("must be nil.")
+	}
+
+	t.Run("unbounded", func(t *testing.T) {
+		f, calls := countingFunction()
+		m := Memoize(f)
+		checkApply(t, m, 1, 2)
+		checkApply(t, m, 1, 2)
+		checkApply(t, m, 2, 4)
+		got, err := m.Function()(2)
+		if got != 4 || err != nil {
+			t.Errorf("want=%d, got=%d, %v", 4, got, err)
+		}
+		if calls[1] != 1 || calls[2] != 1 {
+			t.Errorf("must be called once for each key: %v", calls)
+		}
+		checkStats(t, m, MemoStats{Hits: 2, Misses: 2, Size: 2})
+	})
+
+	t.Run("LRU", func(t *testing.T) {
+		f, calls := countingFunction()
+		m := Memoize(f, MemoLRU(2))
+		checkApply(t, m, 1, 2)
+		checkApply(t, m, 2, 4)
+		checkApply(t, m, 1, 2) // 2 becomes the least recently used.
+		checkApply(t, m, 3, 6)
+		checkApply(t, m, 1, 2)
+		checkApply(t, m, 2, 4)
+		if calls[1] != 1 || calls[2] != 2 || calls[3] != 1 {
+			t.Errorf("wrong calls: %v", calls)
+		}
+		checkStats(t, m, MemoStats{Hits: 2, Misses: 4, Evictions: 2, Size: 2})
+	})
+
+	t.Run("TTL", func(t *testing.T) {
+		now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		f, calls := countingFunction()
+		m := Memoize(f, MemoTTL(time.Minute), MemoClock(func() time.Time { return now }))
+		checkApply(t, m, 1, 2)
+		now = now.Add(59 * time.Second)
+		checkApply(t, m, 1, 2)
+		now = now.Add(time.Second)
+		checkApply(t, m, 1, 2)
+		if calls[1] != 2 {
+			t.Errorf("want=%d calls, got=%d calls", 2, calls[1])
+		}
+		checkStats(t, m, MemoStats{Hits: 1, Misses: 2, Evictions: 1, Size: 1})
+	})
+
+	t.Run("TTL sweeps expired entries", func(t *testing.T) {
+		now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		f, _ := countingFunction()
+		m := Memoize(f, MemoTTL(time.Minute), MemoClock(func() time.Time { return now }))
+		checkApply(t, m, 1, 2)
+		now = now.Add(30 * time.Second)
+		checkApply(t, m, 2, 4)
+		checkApply(t, m, 1, 2) // Hits do not extend the expiry.
+		now = now.Add(30 * time.Second)
+		checkStats(t, m, MemoStats{Hits: 1, Misses: 2, Evictions: 1, Size: 1})
+		checkApply(t, m, 3, 6)
+		now = now.Add(time.Minute)
+		checkApply(t, m, 4, 8)
+		checkStats(t, m, MemoStats{Hits: 1, Misses: 4, Evictions: 3, Size: 1})
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		want := errors.New("foo")
+		n := 0
+		f := func(int) (int, error) {
+			n++
+			return 0, want
+		}
+		m := Memoize(f)
+		for range 2 {
+			if _, err := m.Apply(1); err != want {
+				t.Errorf("want=%q, got=%q", want, err)
+			}
+		}
+		if n != 2 {
+			t.Errorf("errors must not be cached: %d calls", n)
+		}
+
+		n = 0
+		m = Memoize(f, MemoCacheErrors())
+		for range 2 {
+			if _, err := m.Apply(1); err != want {
+				t.Errorf("want=%q, got=%q", want, err)
+			}
+		}
+		if n != 1 {
+			t.Errorf("errors must be cached: %d calls", n)
+		}
+	})
+
+	t.Run("concurrent calls collapse", func(t *testing.T) {
+		var n atomic.Int32
+		start := make(chan struct{})
+		m := Memoize(func(k int) (int, error) {
+			n.Add(1)
+			<-start
+			return k * 2, nil
+		})
+		const callers = 8
+		var wg sync.WaitGroup
+		for range callers {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				checkApply(t, m, 1, 2)
+			}()
+		}
+		for m.Stats().Hits+m.Stats().Misses < callers {
+			time.Sleep(time.Millisecond)
+		}
+		close(start)
+		wg.Wait()
+		if got := n.Load(); got != 1 {
+			t.Errorf("want=%d calls, got=%d calls", 1, got)
+		}
+		checkStats(t, m, MemoStats{Hits: callers - 1, Misses: 1, Size: 1})
+	})
+
+	t.Run("panic", func(t *testing.T) {
+		start := make(chan struct{})
+		m := Memoize(func(int) (int, error) {
+			<-start
+			panic("foo")
+		})
+		done := make(chan error)
+		go func() {
+			defer func() {
+				if v := recover(); v != "foo" {
+					t.Errorf("must panic with foo but %v", v)
+				}
+				close(done)
+			}()
+			m.Apply(1)
+		}()
+		for m.Stats().Misses == 0 {
+			time.Sleep(time.Millisecond)
+		}
+		waiter := make(chan error)
+		go func() {
+			_, err := m.Apply(1)
+			waiter <- err
+		}()
+		for m.Stats().Hits == 0 {
+			time.Sleep(time.Millisecond)
+		}
+		close(start)
+		<-done
+		var pe *ufunction.PanicError
+		if err := <-waiter; !errors.As(err, &pe) {
+			t.Errorf("must be PanicError but %q.", err)
+		}
+		checkStats(t, m, MemoStats{Hits: 1, Misses: 1})
+	})
+}
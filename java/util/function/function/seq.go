@@ -0,0 +1,44 @@
+package function
+
+import "iter"
+
+// MapSeq returns a sequence which yields the results of applying f to
+// each element of seq with nil error. If f returns error, the
+// sequence yields the zero value and the error, then stops.
+// MapSeq returns nil if seq or f is nil.
+func MapSeq[T any, U any](seq iter.Seq[T], f Function[T, U]) iter.Seq2[U, error] {
+	if seq == nil || f == nil {
+		return nil
+	}
+	return func(yield func(U, error) bool) {
+		for in := range seq {
+			u, err := f(in)
+			if !yield(u, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// MapSeq2 is the same as [MapSeq] but seq yields each element with an
+// error. If seq yields an error, the sequence yields the zero value
+// and the error without calling f, then stops.
+// MapSeq2 returns nil if seq or f is nil.
+func MapSeq2[T any, U any](seq iter.Seq2[T, error], f Function[T, U]) iter.Seq2[U, error] {
+	if seq == nil || f == nil {
+		return nil
+	}
+	return func(yield func(U, error) bool) {
+		for in, err := range seq {
+			if err != nil {
+				var zero U
+				yield(zero, err)
+				return
+			}
+			u, err := f(in)
+			if !yield(u, err) || err != nil {
+				return
+			}
+		}
+	}
+}
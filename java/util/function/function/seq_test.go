@@ -0,0 +1,88 @@
+package function
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func collect[T any](t *testing.T, seq func(func(T, error) bool)) ([]T, error) {
+	t.Helper()
+	var ret []T
+	for v, err := range seq {
+		if err != nil {
+			return ret, err
+		}
+		ret = append(ret, v)
+	}
+	return ret, nil
+}
+
+func TestMapSeq(t *testing.T) {
+	got, err := collect(t, MapSeq(slices.Values([]int{1, 2, 3}), wne(strconv.Itoa)))
+	if err != nil {
+		t.Fatalf("must not return error: %s", err)
+	}
+	if diff := cmp.Diff([]string{"1", "2", "3"}, got); diff != "" {
+		t.Error(diff)
+	}
+
+	got2, err := collect(t, MapSeq(slices.Values([]string{"1", "a", "3"}), strconv.Atoi))
+	if err == nil {
+		t.Fatal("must return error.")
+	}
+	if diff := cmp.Diff([]int{1}, got2); diff != "" {
+		t.Error(diff)
+	}
+
+	for range MapSeq(slices.Values([]int{1, 2, 3}), wne(strconv.Itoa)) {
+		break
+	}
+
+	if MapSeq(nil, wne(strconv.Itoa)) != nil {
+		t.Error("must be nil.")
+	}
+	if MapSeq(slices.Values([]int{1}), Function[int, int](nil)) != nil {
+		t.Error("must be nil.")
+	}
+}
+
+func TestMapSeq2(t *testing.T) {
+	strs := MapSeq(slices.Values([]int{1, 2, 3}), wne(strconv.Itoa))
+	got, err := collect(t, MapSeq2(strs, strconv.Atoi))
+	if err != nil {
+		t.Fatalf("must not return error: %s", err)
+	}
+	if diff := cmp.Diff([]int{1, 2, 3}, got); diff != "" {
+		t.Error(diff)
+	}
+
+	want := errors.New("foo")
+	failing := MapSeq(slices.Values([]int{1, 2, 3}), func(i int) (int, error) {
+		if i == 2 {
+			return 0, want
+		}
+		return i, nil
+	})
+	called := 0
+	got, err = collect(t, MapSeq2(failing, func(i int) (int, error) {
+		called++
+		return i, nil
+	}))
+	if err != want {
+		t.Fatalf("want=%q, got=%q", want, err)
+	}
+	if diff := cmp.Diff([]int{1}, got); diff != "" {
+		t.Error(diff)
+	}
+	if called != 1 {
+		t.Errorf("want=1, got=%d", called)
+	}
+
+	if MapSeq2(nil, Identity[int]()) != nil {
+		t.Error("must be nil.")
+	}
+}
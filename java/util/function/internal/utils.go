@@ -2,6 +2,7 @@ package internal
 
 import (
 	"reflect"
+	"sync"
 
 	"github.com/dairyo/j2g/java/util/function"
 )
@@ -15,21 +16,87 @@ func cast[T any](i any) (T, error) {
 	return ret, nil
 }
 
-func Cast[T any, U any]() func(T) (U, error) {
-	tt := reflect.TypeOf((*T)(nil)).Elem()
-	ut := reflect.TypeOf((*U)(nil)).Elem()
-	switch ut.Kind() {
-	case reflect.Interface:
+// planKind is a way to cast a value of a type to another type.
+type planKind int
+
+const (
+	// planImplements casts a value to an interface it implements.
+	planImplements planKind = iota + 1
+	// planConvert converts a value with reflect.Value.Convert.
+	planConvert
+	// planAssert asserts a value in an interface to a type
+	// implementing the interface.
+	planAssert
+)
+
+type plan struct {
+	kind planKind
+	err  error
+}
+
+type typePair struct {
+	from, to reflect.Type
+}
+
+// plans caches plan for each typePair because finding a plan with
+// reflection is slow.
+var plans sync.Map
+
+func planOf(tt, ut reflect.Type) plan {
+	key := typePair{tt, ut}
+	if p, ok := plans.Load(key); ok {
+		return p.(plan)
+	}
+	p := newPlan(tt, ut)
+	plans.Store(key, p)
+	return p
+}
+
+func newPlan(tt, ut reflect.Type) plan {
+	if ut.Kind() == reflect.Interface {
 		if tt.Implements(ut) {
-			return func(in T) (U, error) { return cast[U](reflect.ValueOf(in).Interface()) }
+			return plan{kind: planImplements}
 		}
-	default:
-		if tt.ConvertibleTo(ut) {
-			return func(in T) (U, error) { return cast[U](reflect.ValueOf(in).Convert(ut).Interface()) }
+		return plan{err: &function.CastError{From: tt, To: ut}}
+	}
+	if tt.ConvertibleTo(ut) {
+		return plan{kind: planConvert}
+	}
+	if tt.Kind() == reflect.Interface && ut.Implements(tt) {
+		return plan{kind: planAssert}
+	}
+	return plan{err: &function.CastError{From: tt, To: ut}}
+}
+
+// CastE returns a function which casts T to U. If T can not be cast
+// to U, CastE returns a [*function.CastError] describing why. The
+// returned function returns the zero value of U for a nil interface.
+func CastE[T any, U any]() (func(T) (U, error), error) {
+	tt := reflect.TypeOf((*T)(nil)).Elem()
+	ut := reflect.TypeOf((*U)(nil)).Elem()
+	p := planOf(tt, ut)
+	if p.err != nil {
+		return nil, p.err
+	}
+	return func(in T) (U, error) {
+		v := reflect.ValueOf(in)
+		if !v.IsValid() {
+			var zero U
+			return zero, nil
 		}
-		if tt.Kind() == reflect.Interface && ut.Implements(tt) {
-			return func(in T) (U, error) { return cast[U](reflect.ValueOf(in).Interface()) }
+		if p.kind == planConvert {
+			v = v.Convert(ut)
 		}
+		return cast[U](v.Interface())
+	}, nil
+}
+
+// Cast is the same as [CastE] but returns nil if T can not be cast
+// to U.
+func Cast[T any, U any]() func(T) (U, error) {
+	f, err := CastE[T, U]()
+	if err != nil {
+		return nil
 	}
-	return nil
+	return f
 }
@@ -2,8 +2,12 @@ package internal
 
 import (
 	"bytes"
+	"errors"
 	"io"
+	"reflect"
 	"testing"
+
+	"github.com/dairyo/j2g/java/util/function"
 )
 
 type (
@@ -75,3 +79,41 @@ func TestCast(t *testing.T) {
 	}()
 
 }
+
+func TestCastE(t *testing.T) {
+	_, err := CastE[*bytes.Buffer, io.Closer]()
+	if !errors.Is(err, function.ErrFailToCast) {
+		t.Fatalf("must be ErrFailToCast but %v.", err)
+	}
+	if want := "*bytes.Buffer does not implement io.Closer"; err.Error() != want {
+		t.Errorf("want=%q, got=%q", want, err.Error())
+	}
+	_, err = CastE[int, *bytes.Buffer]()
+	if want := "int is not convertible to *bytes.Buffer"; err == nil || err.Error() != want {
+		t.Errorf("want=%q, got=%v", want, err)
+	}
+
+	cast, err := CastE[io.Writer, *bytes.Buffer]()
+	if err != nil {
+		t.Fatalf("must not return err: %s", err)
+	}
+	got, err := cast(nil)
+	if err != nil {
+		t.Errorf("must not return err: %s", err)
+	}
+	if got != nil {
+		t.Errorf("must be nil but %v.", got)
+	}
+	if _, err := cast(io.Discard); !errors.Is(err, function.ErrFailToCast) {
+		t.Errorf("must be ErrFailToCast but %v.", err)
+	}
+
+	key := typePair{reflect.TypeFor[io.Writer](), reflect.TypeFor[*bytes.Buffer]()}
+	p, ok := plans.Load(key)
+	if !ok {
+		t.Fatal("plan must be cached.")
+	}
+	if k := p.(plan).kind; k != planAssert {
+		t.Errorf("want=%d, got=%d", planAssert, k)
+	}
+}
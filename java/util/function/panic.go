@@ -0,0 +1,42 @@
+package function
+
+import (
+	"fmt"
+	"runtime/debug"
+)
+
+// PanicError is an error converted from a panic by the Recover
+// functions of the functional types.
+type PanicError struct {
+	// Value is the value passed to panic.
+	Value any
+	// Stack is the stack trace of the goroutine at the time of the
+	// panic.
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Unwrap returns Value if Value is an error. Otherwise returns nil.
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+	return nil
+}
+
+// RecoverAsError recovers from a panic and sets a [*PanicError] to
+// err. RecoverAsError must be called directly with defer like
+// following:
+//
+//	func f() (err error) {
+//		defer function.RecoverAsError(&err)
+//		...
+//	}
+func RecoverAsError(err *error) {
+	if v := recover(); v != nil {
+		*err = &PanicError{Value: v, Stack: debug.Stack()}
+	}
+}
@@ -0,0 +1,44 @@
+package function
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestRecoverAsError(t *testing.T) {
+	f := func(v any) (err error) {
+		defer RecoverAsError(&err)
+		if v != nil {
+			panic(v)
+		}
+		return nil
+	}
+
+	if err := f(nil); err != nil {
+		t.Errorf("must not return error: %s", err)
+	}
+
+	var pe *PanicError
+	err := f("foo")
+	if !errors.As(err, &pe) {
+		t.Fatalf("must be PanicError but %q.", err)
+	}
+	if pe.Value != "foo" {
+		t.Errorf("want=%q, got=%v", "foo", pe.Value)
+	}
+	if err.Error() != "panic: foo" {
+		t.Errorf("want=%q, got=%q", "panic: foo", err.Error())
+	}
+	if !strings.Contains(string(pe.Stack), "TestRecoverAsError") {
+		t.Errorf("stack must contain the caller but %s", pe.Stack)
+	}
+	if pe.Unwrap() != nil {
+		t.Error("must be nil.")
+	}
+
+	want := errors.New("bar")
+	if err := f(want); !errors.Is(err, want) {
+		t.Errorf("error must contain %q but %q.", want, err)
+	}
+}
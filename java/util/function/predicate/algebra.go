@@ -0,0 +1,109 @@
+package predicate
+
+import "slices"
+
+const (
+	opAnd     = "AND"
+	opOr      = "OR"
+	opNot     = "NOT"
+	opXor     = "XOR"
+	opExactly = "EXACTLY"
+)
+
+// decide returns the result of an op node with operands evaluated so
+// far, count of which return true, and rest operands not evaluated
+// yet. done reports that the result is decided regardless of the rest
+// operands. done is always true if rest is 0. k is the number of
+// operands which must return true for [Exactly].
+func decide(op string, k, count, evaluated, rest int) (result, done bool) {
+	switch op {
+	case opAnd:
+		return count == evaluated, count != evaluated || rest == 0
+	case opOr:
+		return count > 0, count > 0 || rest == 0
+	case opXor:
+		return count%2 == 1, rest == 0
+	case opExactly:
+		if count > k || count+rest < k {
+			return false, true
+		}
+		return count == k, rest == 0
+	}
+	return false, true
+}
+
+// fold returns a Predicate which evaluates ps in order until its
+// result is decided by [decide]. If a Predicate in ps returns error,
+// rest of ps are not evaluated and the error is returned.
+func fold[T any](op string, k int, ps []Predicate[T]) Predicate[T] {
+	if slices.ContainsFunc(ps, func(p Predicate[T]) bool { return p == nil }) {
+		return nil
+	}
+	ps = slices.Clone(ps)
+	return func(in T) (bool, error) {
+		ret, done := decide(op, k, 0, 0, len(ps))
+		count := 0
+		for i, p := range ps {
+			if done {
+				break
+			}
+			ok, err := p(in)
+			if err != nil {
+				return false, err
+			}
+			if ok {
+				count++
+			}
+			ret, done = decide(op, k, count, i+1, len(ps)-i-1)
+		}
+		return ret, nil
+	}
+}
+
+// AllOf returns a Predicate composed by ps as [And] does. Unlike And,
+// AllOf accepts any number of Predicates. If ps is empty, the
+// composed Predicate always returns true.
+// If any of ps is nil, this function returns nil.
+func AllOf[T any](ps ...Predicate[T]) Predicate[T] {
+	return fold(opAnd, 0, ps)
+}
+
+// AnyOf returns a Predicate composed by ps as [Or] does. Unlike Or,
+// AnyOf accepts any number of Predicates. If ps is empty, the
+// composed Predicate always returns false.
+// If any of ps is nil, this function returns nil.
+func AnyOf[T any](ps ...Predicate[T]) Predicate[T] {
+	return fold(opOr, 0, ps)
+}
+
+// NoneOf returns a Predicate which returns true if none of ps returns
+// true. The composed Predicate is the negation of [AnyOf], so it is
+// short-circuiting and always returns true if ps is empty.
+// If any of ps is nil, this function returns nil.
+func NoneOf[T any](ps ...Predicate[T]) Predicate[T] {
+	return Not(AnyOf(ps...))
+}
+
+// Xor returns a Predicate composed by arguments. The composed
+// Predicate is a logical exclusive OR, which returns true if an odd
+// number of the Predicates return true. All Predicates are evaluated
+// in the order of arguments of this function unless one of them
+// returns error.
+// If any of the arguments is nil, this function returns nil.
+func Xor[T any](p1, p2 Predicate[T], p3 ...Predicate[T]) Predicate[T] {
+	ps := newPredicates(p1, p2, p3...)
+	if ps == nil {
+		return nil
+	}
+	return fold(opXor, 0, ps)
+}
+
+// Exactly returns a Predicate which returns true if exactly n of ps
+// return true. The order of evaluating Predicates is as same as the
+// order of ps. Once more than n Predicates return true, or the rest
+// of Predicates are not enough to make n, or a Predicate returns
+// error, the rest of Predicates are not evaluated.
+// If any of ps is nil, this function returns nil.
+func Exactly[T any](n int, ps ...Predicate[T]) Predicate[T] {
+	return fold(opExactly, n, ps)
+}
@@ -0,0 +1,93 @@
+package predicate
+
+import (
+	"errors"
+	"testing"
+)
+
+// counted returns a Predicate which returns ok and counts calls.
+func counted(ok bool, calls *int) Predicate[int] {
+	return func(int) (bool, error) {
+		*calls++
+		return ok, nil
+	}
+}
+
+var (
+	yes = wnep(func(int) bool { return true })
+	no  = wnep(func(int) bool { return false })
+)
+
+func TestAllOfAnyOfNoneOf(t *testing.T) {
+	checkPredicate(t, AllOf[int](), 0, true)
+	checkPredicate(t, AnyOf[int](), 0, false)
+	checkPredicate(t, NoneOf[int](), 0, true)
+
+	checkPredicate(t, AllOf(yes), 0, true)
+	checkPredicate(t, AllOf(yes, no, yes), 0, false)
+	checkPredicate(t, AnyOf(no, no), 0, false)
+	checkPredicate(t, AnyOf(no, yes), 0, true)
+	checkPredicate(t, NoneOf(no, no), 0, true)
+	checkPredicate(t, NoneOf(no, yes), 0, false)
+
+	calls := 0
+	checkPredicate(t, AllOf(no, counted(true, &calls)), 0, false)
+	checkPredicate(t, AnyOf(yes, counted(true, &calls)), 0, true)
+	if calls != 0 {
+		t.Errorf("must short-circuit but called %d times.", calls)
+	}
+
+	e := errors.New("error")
+	fail := Predicate[int](func(int) (bool, error) { return true, e })
+	checkPredicateError(t, AllOf(yes, fail, counted(true, &calls)), 0, e)
+	checkPredicateError(t, NoneOf(no, fail, counted(true, &calls)), 0, e)
+	if calls != 0 {
+		t.Errorf("must stop at error but called %d times.", calls)
+	}
+
+	ps := []Predicate[int]{yes, yes}
+	all := AllOf(ps...)
+	ps[1] = no
+	checkPredicate(t, all, 0, true)
+
+	if AllOf(yes, nil) != nil || AnyOf(nil, yes) != nil || NoneOf[int](nil) != nil {
+		t.Error("must be nil.")
+	}
+}
+
+func TestXor(t *testing.T) {
+	checkPredicate(t, Xor(yes, no), 0, true)
+	checkPredicate(t, Xor(yes, yes), 0, false)
+	checkPredicate(t, Xor(no, no), 0, false)
+	checkPredicate(t, Xor(yes, yes, yes), 0, true)
+
+	e := errors.New("error")
+	calls := 0
+	fail := Predicate[int](func(int) (bool, error) { return false, e })
+	checkPredicateError(t, Xor(yes, fail, counted(true, &calls)), 0, e)
+	if calls != 0 {
+		t.Errorf("must stop at error but called %d times.", calls)
+	}
+	if Xor(yes, nil) != nil {
+		t.Error("must be nil.")
+	}
+}
+
+func TestExactly(t *testing.T) {
+	checkPredicate(t, Exactly[int](0), 0, true)
+	checkPredicate(t, Exactly[int](1), 0, false)
+	checkPredicate(t, Exactly(1, no, yes, no), 0, true)
+	checkPredicate(t, Exactly(2, no, yes, no), 0, false)
+	checkPredicate(t, Exactly(2, yes, no, yes), 0, true)
+
+	calls := 0
+	checkPredicate(t, Exactly(1, yes, yes, counted(true, &calls)), 0, false)
+	checkPredicate(t, Exactly(2, no, no, counted(true, &calls)), 0, false)
+	checkPredicate(t, Exactly(3, counted(true, &calls), yes), 0, false)
+	if calls != 0 {
+		t.Errorf("must short-circuit but called %d times.", calls)
+	}
+	if Exactly(1, yes, nil) != nil {
+		t.Error("must be nil.")
+	}
+}
@@ -0,0 +1,252 @@
+package predicate
+
+import (
+	"strings"
+
+	"github.com/dairyo/j2g/java/util/function"
+)
+
+// Expr is a Predicate which keeps its structure, so that it is
+// rendered as an expression by [Expr.String] and its evaluation is
+// traced by [Explain]. An Expr is created by [Named] and composed by
+// its methods [Expr.And], [Expr.Or], [Expr.Xor] and [Expr.Negate],
+// which correspond to the default methods of
+// java.util.function.Predicate. For example:
+//
+//	isAdult := Named("isAdult", func(c customer) (bool, error) { return c.age >= 18, nil })
+//	isVIP := Named("isVIP", func(c customer) (bool, error) { return c.vip, nil })
+//	hasCoupon := Named("hasCoupon", func(c customer) (bool, error) { return c.coupon, nil })
+//	eligible := isAdult.And(isVIP.Or(hasCoupon))
+//
+// The method value [Expr.Test] is a [Predicate], so an Expr can be
+// passed to any function taking a Predicate.
+type Expr[T any] struct {
+	// name is the name given by Named. An Expr without name is
+	// composed by op from children.
+	name     string
+	op       string
+	children []*Expr[T]
+	// p is the Predicate given by Named. It is nil if the Expr wraps
+	// another Expr by [Expr.Named].
+	p Predicate[T]
+}
+
+// Named returns an [Expr] which behaves as p and is rendered as name
+// by [Expr.String] and [Explain]. If name is empty, the Expr is
+// rendered as "<unnamed>".
+// If p is nil, this function returns nil.
+func Named[T any](name string, p Predicate[T]) *Expr[T] {
+	if p == nil {
+		return nil
+	}
+	return &Expr[T]{name: name, p: p}
+}
+
+// Named returns an [Expr] which behaves as e and is rendered as name.
+// Unlike passing e.Test to [Named], [Explain] traces the structure of
+// e under name.
+// If e is nil, this function returns nil. If name is empty, this
+// function returns e.
+func (e *Expr[T]) Named(name string) *Expr[T] {
+	if e == nil || name == "" {
+		return e
+	}
+	return &Expr[T]{name: name, children: []*Expr[T]{e}}
+}
+
+func (e *Expr[T]) compose(op string, others []*Expr[T]) *Expr[T] {
+	if e == nil {
+		return nil
+	}
+	children := make([]*Expr[T], 0, 1+len(others))
+	children = append(children, e)
+	for _, o := range others {
+		if o == nil {
+			return nil
+		}
+		children = append(children, o)
+	}
+	return &Expr[T]{op: op, children: children}
+}
+
+// And returns an [Expr] composed by e and others as [And] does.
+// If e or any of others is nil, this function returns nil.
+func (e *Expr[T]) And(others ...*Expr[T]) *Expr[T] {
+	return e.compose(opAnd, others)
+}
+
+// Or returns an [Expr] composed by e and others as [Or] does.
+// If e or any of others is nil, this function returns nil.
+func (e *Expr[T]) Or(others ...*Expr[T]) *Expr[T] {
+	return e.compose(opOr, others)
+}
+
+// Xor returns an [Expr] composed by e and others as [Xor] does.
+// If e or any of others is nil, this function returns nil.
+func (e *Expr[T]) Xor(others ...*Expr[T]) *Expr[T] {
+	return e.compose(opXor, others)
+}
+
+// Negate returns an [Expr] which returns negation of e as [Not]
+// does.
+// If e is nil, this function returns nil.
+func (e *Expr[T]) Negate() *Expr[T] {
+	if e == nil {
+		return nil
+	}
+	return &Expr[T]{op: opNot, children: []*Expr[T]{e}}
+}
+
+// Test evaluates e with in. The order of evaluation and
+// short-circuiting are the same as the functions composing
+// Predicates such as [And] and [Or].
+// If e is nil, Test returns [function.ErrNilFunctional].
+func (e *Expr[T]) Test(in T) (bool, error) {
+	switch {
+	case e == nil:
+		return false, function.ErrNilFunctional
+	case e.p != nil:
+		return e.p(in)
+	case e.name != "":
+		return e.children[0].Test(in)
+	case e.op == opNot:
+		ok, err := e.children[0].Test(in)
+		if err != nil {
+			return false, err
+		}
+		return !ok, nil
+	}
+	ret, done := decide(e.op, 0, 0, 0, len(e.children))
+	count := 0
+	for i, c := range e.children {
+		if done {
+			break
+		}
+		ok, err := c.Test(in)
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			count++
+		}
+		ret, done = decide(e.op, 0, count, i+1, len(e.children)-i-1)
+	}
+	return ret, nil
+}
+
+// String renders e as an expression of the names given by [Named]
+// such as:
+//
+//	(isAdult AND (isVIP OR hasCoupon))
+func (e *Expr[T]) String() string {
+	switch {
+	case e == nil:
+		return "<nil>"
+	case e.p != nil && e.name == "":
+		return "<unnamed>"
+	case e.p != nil || e.name != "":
+		return e.name
+	case e.op == opNot:
+		return opNot + " " + e.children[0].String()
+	}
+	exprs := make([]string, len(e.children))
+	for i, c := range e.children {
+		exprs[i] = c.String()
+	}
+	return "(" + strings.Join(exprs, " "+e.op+" ") + ")"
+}
+
+// Trace is the evaluation of a node of an [Expr] returned by
+// [Explain].
+type Trace struct {
+	// Expr is the node rendered by [Expr.String].
+	Expr string
+	// Result and Err are the results of the node.
+	Result bool
+	Err    error
+	// Skipped reports that the node is not evaluated because the
+	// result of the enclosing node is decided by preceding operands.
+	Skipped bool
+	// Children are the traces of the operands of a composed Expr, or
+	// of the Expr renamed by [Expr.Named].
+	Children []*Trace
+}
+
+// String renders t and its children as indented lines such as:
+//
+//	(isAdult AND (isVIP OR hasCoupon)): false
+//	  isAdult: true
+//	  (isVIP OR hasCoupon): false
+//	    isVIP: false
+//	    hasCoupon: false
+func (t *Trace) String() string {
+	var b strings.Builder
+	t.write(&b, 0)
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func (t *Trace) write(b *strings.Builder, depth int) {
+	b.WriteString(strings.Repeat("  ", depth))
+	b.WriteString(t.Expr)
+	switch {
+	case t.Skipped:
+		b.WriteString(": skipped\n")
+	case t.Err != nil:
+		b.WriteString(": error: " + t.Err.Error() + "\n")
+	case t.Result:
+		b.WriteString(": true\n")
+	default:
+		b.WriteString(": false\n")
+	}
+	for _, c := range t.Children {
+		c.write(b, depth+1)
+	}
+}
+
+// Explain evaluates e with in and returns the trace of each node of
+// e. The Result and Err of the returned Trace are the same as what
+// [Expr.Test] returns.
+// If e is nil, the Err of the returned Trace is
+// [function.ErrNilFunctional].
+func Explain[T any](e *Expr[T], in T) *Trace {
+	t := &Trace{Expr: e.String()}
+	switch {
+	case e == nil:
+		t.Err = function.ErrNilFunctional
+	case e.p != nil:
+		t.Result, t.Err = e.p(in)
+	case e.name != "":
+		c := Explain(e.children[0], in)
+		t.Children = []*Trace{c}
+		t.Result, t.Err = c.Result, c.Err
+	case e.op == opNot:
+		c := Explain(e.children[0], in)
+		t.Children = []*Trace{c}
+		if c.Err != nil {
+			t.Err = c.Err
+			break
+		}
+		t.Result = !c.Result
+	default:
+		var done bool
+		count := 0
+		t.Result, done = decide(e.op, 0, 0, 0, len(e.children))
+		for i, ce := range e.children {
+			if done {
+				t.Children = append(t.Children, &Trace{Expr: ce.String(), Skipped: true})
+				continue
+			}
+			c := Explain(ce, in)
+			t.Children = append(t.Children, c)
+			if c.Err != nil {
+				t.Result, t.Err, done = false, c.Err, true
+				continue
+			}
+			if c.Result {
+				count++
+			}
+			t.Result, done = decide(e.op, 0, count, i+1, len(e.children)-i-1)
+		}
+	}
+	return t
+}
@@ -0,0 +1,106 @@
+package predicate
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dairyo/j2g/java/util/function"
+)
+
+type customer struct {
+	age       int
+	vip       bool
+	hasCoupon bool
+}
+
+func rules() *Expr[customer] {
+	isAdult := Named("isAdult", WrapNoErr(func(c customer) bool { return c.age >= 18 }))
+	isVIP := Named("isVIP", WrapNoErr(func(c customer) bool { return c.vip }))
+	hasCoupon := Named("hasCoupon", WrapNoErr(func(c customer) bool { return c.hasCoupon }))
+	return isAdult.And(isVIP.Or(hasCoupon))
+}
+
+func checkExpr[T any](t *testing.T, e *Expr[T], want string) {
+	t.Helper()
+	if got := e.String(); want != got {
+		t.Errorf("want=%q, got=%q", want, got)
+	}
+}
+
+func TestExpr(t *testing.T) {
+	e := rules()
+	checkExpr(t, e, "(isAdult AND (isVIP OR hasCoupon))")
+	checkPredicate(t, e.Test, customer{age: 20, hasCoupon: true}, true)
+	checkPredicate(t, e.Test, customer{age: 17, vip: true}, false)
+	checkPredicate(t, And(e.Test, WrapNoErr(func(c customer) bool { return c.vip })), customer{age: 20, vip: true}, true)
+
+	eligible := e.Named("eligible")
+	checkExpr(t, eligible, "eligible")
+	checkExpr(t, eligible.Negate(), "NOT eligible")
+	checkPredicate(t, eligible.Negate().Test, customer{age: 17}, true)
+	if e.Named("") != e {
+		t.Error("must return the same Expr.")
+	}
+
+	a := Named("a", WrapNoErr(func(int) bool { return true }))
+	b := Named("b", WrapNoErr(func(int) bool { return false }))
+	checkExpr(t, a.Xor(b, a), "(a XOR b XOR a)")
+	checkPredicate(t, a.Xor(b).Test, 0, true)
+	checkPredicate(t, a.Xor(b, a).Test, 0, false)
+	checkExpr(t, Named("", WrapNoErr(func(int) bool { return true })), "<unnamed>")
+
+	if Named[int]("nil", nil) != nil || a.And(nil) != nil || a.Or(b, nil) != nil {
+		t.Error("must be nil.")
+	}
+	var n *Expr[int]
+	checkExpr(t, n, "<nil>")
+	if n.Negate() != nil || n.And(a) != nil || n.Named("n") != nil {
+		t.Error("must be nil.")
+	}
+	if _, err := n.Test(0); !errors.Is(err, function.ErrNilFunctional) {
+		t.Errorf("must be ErrNilFunctional but %v.", err)
+	}
+}
+
+func TestExplain(t *testing.T) {
+	e := rules()
+	tr := Explain(e, customer{age: 17, vip: true})
+	if tr.Result || tr.Err != nil {
+		t.Errorf("want=(false, nil), got=(%t, %v)", tr.Result, tr.Err)
+	}
+	want := `(isAdult AND (isVIP OR hasCoupon)): false
+  isAdult: false
+  (isVIP OR hasCoupon): skipped`
+	if got := tr.String(); want != got {
+		t.Errorf("want=%q, got=%q", want, got)
+	}
+
+	tr = Explain(e.Named("eligible"), customer{age: 20, hasCoupon: true})
+	want = `eligible: true
+  (isAdult AND (isVIP OR hasCoupon)): true
+    isAdult: true
+    (isVIP OR hasCoupon): true
+      isVIP: false
+      hasCoupon: true`
+	if got := tr.String(); want != got {
+		t.Errorf("want=%q, got=%q", want, got)
+	}
+
+	e1 := errors.New("error")
+	fail := Named("fail", func(customer) (bool, error) { return false, e1 })
+	tr = Explain(fail.Or(e).Negate(), customer{})
+	if !errors.Is(tr.Err, e1) {
+		t.Errorf("want=%v, got=%v", e1, tr.Err)
+	}
+	want = `NOT (fail OR (isAdult AND (isVIP OR hasCoupon))): error: error
+  (fail OR (isAdult AND (isVIP OR hasCoupon))): error: error
+    fail: error: error
+    (isAdult AND (isVIP OR hasCoupon)): skipped`
+	if got := tr.String(); want != got {
+		t.Errorf("want=%q, got=%q", want, got)
+	}
+
+	if tr = Explain[int](nil, 1); !errors.Is(tr.Err, function.ErrNilFunctional) {
+		t.Errorf("must be ErrNilFunctional but %v.", tr.Err)
+	}
+}
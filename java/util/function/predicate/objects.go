@@ -0,0 +1,68 @@
+package predicate
+
+import (
+	"reflect"
+
+	"github.com/dairyo/j2g/java/util/function"
+	"github.com/google/go-cmp/cmp"
+)
+
+/**
+This is a port of predicates in java.util.Objects.
+
+* https://docs.oracle.com/en/java/javase/21/docs/api/java.base/java/util/Objects.html
+* https://github.com/openjdk/jdk/blob/jdk-21%2B35/src/java.base/share/classes/java/util/Objects.java
+*/
+
+// IsEqual returns a Predicate which tests an argument is equal to v
+// as Objects.equals in Java. Unlike [ComparableEquals], T does not
+// need to be comparable.
+//
+// If T is comparable, the equality is determined by == as
+// [ComparableEquals] does, so pointers are equal only if they point
+// to the same variable, and opts are not used. Comparing interface
+// values holding the same non-comparable type panics, and the
+// Predicate returns a [*function.PanicError] for the panic.
+//
+// Otherwise, the equality is determined by [cmp.Equal] with opts, so
+// the Equal method of T is used if T has it, and two nil values are
+// equal. cmp.Equal panics for unexported fields unless an option such
+// as [cmp.AllowUnexported] is given, and the Predicate returns a
+// [*function.PanicError] for the panic.
+func IsEqual[T any](v T, opts ...cmp.Option) Predicate[T] {
+	if reflect.TypeFor[T]().Comparable() {
+		return func(in T) (ok bool, err error) {
+			defer function.RecoverAsError(&err)
+			return any(v) == any(in), nil
+		}
+	}
+	return func(in T) (ok bool, err error) {
+		defer function.RecoverAsError(&err)
+		return cmp.Equal(v, in, opts...), nil
+	}
+}
+
+// IsNil returns a Predicate which tests an argument is nil as
+// Objects.isNull in Java. The Predicate always returns false if T
+// can not be nil, such as int and struct types. If T is an interface,
+// an interface holding a typed nil pointer is not nil as in Go.
+func IsNil[T any]() Predicate[T] {
+	return func(in T) (bool, error) {
+		v := reflect.ValueOf(&in).Elem()
+		switch v.Kind() {
+		case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map,
+			reflect.Pointer, reflect.Slice, reflect.UnsafePointer:
+			return v.IsNil(), nil
+		}
+		return false, nil
+	}
+}
+
+// IsZero returns a Predicate which tests an argument is the zero
+// value of T. Unlike comparing with ==, T does not need to be
+// comparable.
+func IsZero[T any]() Predicate[T] {
+	return func(in T) (bool, error) {
+		return reflect.ValueOf(&in).Elem().IsZero(), nil
+	}
+}
@@ -0,0 +1,68 @@
+package predicate
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/dairyo/j2g/java/util/function"
+	"github.com/google/go-cmp/cmp"
+)
+
+type point struct {
+	x, y int
+}
+
+type polygon struct {
+	points []point
+}
+
+func TestIsEqual(t *testing.T) {
+	checkPredicate(t, IsEqual([]int{1, 2}), []int{1, 2}, true)
+	checkPredicate(t, IsEqual([]int{1, 2}), []int{2, 1}, false)
+	checkPredicate(t, IsEqual(map[string]int{"a": 1}), map[string]int{"a": 1}, true)
+	checkPredicate(t, IsEqual[*int](nil), nil, true)
+	checkPredicate(t, IsEqual[*int](nil), new(int), false)
+	checkPredicate(t, IsEqual(polygon{[]point{{1, 2}}}, cmp.AllowUnexported(polygon{}, point{})), polygon{[]point{{1, 2}}}, true)
+
+	ok, err := IsEqual(polygon{[]point{{1, 2}}})(polygon{[]point{{1, 2}}})
+	var pe *function.PanicError
+	if ok || !errors.As(err, &pe) {
+		t.Errorf("want=(false, PanicError), got=(%t, %v)", ok, err)
+	}
+
+	t.Run("comparable", func(t *testing.T) {
+		checkPredicate(t, IsEqual(point{1, 2}), point{1, 2}, true)
+		checkPredicate(t, IsEqual(point{1, 2}), point{2, 1}, false)
+
+		x, y := 1, 1
+		checkPredicate(t, IsEqual(&x), &x, true)
+		checkPredicate(t, IsEqual(&x), &y, false)
+
+		checkPredicate[any](t, IsEqual[any](1), 1, true)
+		checkPredicate[any](t, IsEqual[any](1), int64(1), false)
+		ok, err := IsEqual[any]([]int{1})([]int{1})
+		if ok || !errors.As(err, &pe) {
+			t.Errorf("want=(false, PanicError), got=(%t, %v)", ok, err)
+		}
+	})
+}
+
+func TestIsNil(t *testing.T) {
+	checkPredicate(t, IsNil[*int](), nil, true)
+	checkPredicate(t, IsNil[*int](), new(int), false)
+	checkPredicate(t, IsNil[[]int](), nil, true)
+	checkPredicate(t, IsNil[map[int]int](), map[int]int{}, false)
+	checkPredicate(t, IsNil[io.Writer](), nil, true)
+	checkPredicate[any](t, IsNil[any](), (*int)(nil), false)
+	checkPredicate(t, IsNil[int](), 0, false)
+}
+
+func TestIsZero(t *testing.T) {
+	checkPredicate(t, IsZero[int](), 0, true)
+	checkPredicate(t, IsZero[int](), 1, false)
+	checkPredicate(t, IsZero[point](), point{}, true)
+	checkPredicate(t, IsZero[[]int](), nil, true)
+	checkPredicate(t, IsZero[[]int](), []int{}, false)
+	checkPredicate(t, IsZero[any](), nil, true)
+}
@@ -1,8 +1,10 @@
 package predicate
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/dairyo/j2g/java/util/function"
 	"github.com/dairyo/j2g/java/util/function/internal"
 )
 
@@ -17,6 +19,30 @@ This is a port of java.util.function.Predicate.
 // argument and produce one bool result an error.
 type Predicate[T any] func(T) (bool, error)
 
+// PredicateCtx is a type to represents a function that accepts a
+// [context.Context] and one argument and produce one bool result an
+// error.
+type PredicateCtx[T any] func(context.Context, T) (bool, error)
+
+// WithContext adjusts a Predicate to PredicateCtx ignoring the
+// context.
+// If p is nil, this function returns nil.
+func WithContext[T any](p Predicate[T]) PredicateCtx[T] {
+	if p == nil {
+		return nil
+	}
+	return func(_ context.Context, in T) (bool, error) { return p(in) }
+}
+
+// Bind returns a Predicate which calls p with ctx.
+// If p is nil, this function returns nil.
+func Bind[T any](ctx context.Context, p PredicateCtx[T]) Predicate[T] {
+	if p == nil {
+		return nil
+	}
+	return func(in T) (bool, error) { return p(ctx, in) }
+}
+
 type predicates[T any] []Predicate[T]
 
 func newPredicates[T any](p1, p2 Predicate[T], p3 ...Predicate[T]) predicates[T] {
@@ -112,6 +138,19 @@ func Not[T any](t Predicate[T]) Predicate[T] {
 	}
 }
 
+// Recover returns a Predicate which calls p and converts a panic in p
+// to a [*function.PanicError] returned as error.
+// If p is nil, this function returns nil.
+func Recover[T any](p Predicate[T]) Predicate[T] {
+	if p == nil {
+		return nil
+	}
+	return func(in T) (ok bool, err error) {
+		defer function.RecoverAsError(&err)
+		return p(in)
+	}
+}
+
 // ComparableEquals returns predicate which tests two comparable
 // instance is same or not.
 func ComparableEquals[T comparable](i T) Predicate[T] {
@@ -148,12 +187,21 @@ func ComparableEquals[T comparable](i T) Predicate[T] {
 //  	b := &bytes.Buffer{}
 //  	And(f1, func(b *bytes.Buffer) (bool, error) { return f2(b) })(b)
 func Adjust[T, U any](f func(U) (bool, error)) func(T) (bool, error) {
+	ret, _ := AdjustE[T](f)
+	return ret
+}
+
+// AdjustE is the same as [Adjust] but returns an error describing why
+// f can not be adjusted instead of nil. AdjustE returns
+// [function.ErrNilFunctional] if f is nil, and a
+// [*function.CastError] if T can not be cast to U.
+func AdjustE[T, U any](f func(U) (bool, error)) (func(T) (bool, error), error) {
 	if f == nil {
-		return nil
+		return nil, function.ErrNilFunctional
 	}
-	cf := internal.Cast[T, U]()
-	if cf == nil {
-		return nil
+	cf, err := internal.CastE[T, U]()
+	if err != nil {
+		return nil, err
 	}
 	return func(in T) (bool, error) {
 		u, err := cf(in)
@@ -161,5 +209,5 @@ func Adjust[T, U any](f func(U) (bool, error)) func(T) (bool, error) {
 			return false, fmt.Errorf("fail to cast from %T to %T: %w", in, u, err)
 		}
 		return f(u)
-	}
+	}, nil
 }
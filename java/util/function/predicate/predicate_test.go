@@ -2,11 +2,13 @@ package predicate
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"io"
 	"strings"
 	"testing"
 
+	"github.com/dairyo/j2g/java/util/function"
 	"github.com/google/go-cmp/cmp"
 )
 
@@ -254,3 +256,64 @@ func TestAdjust(t *testing.T) {
 		t.Errorf("should return true.")
 	}
 }
+
+func TestContext(t *testing.T) {
+	type key struct{}
+	ctx := context.WithValue(context.Background(), key{}, 1)
+
+	p1 := WithContext(ComparableEquals(1))
+	ok, err := p1(ctx, 1)
+	if err != nil || !ok {
+		t.Errorf("want=(true, nil), got=(%t, %v)", ok, err)
+	}
+
+	p2 := Bind(ctx, func(ctx context.Context, i int) (bool, error) { return i == ctx.Value(key{}).(int), nil })
+	checkPredicate(t, p2, 1, true)
+	checkPredicate(t, p2, 2, false)
+
+	if WithContext(Predicate[int](nil)) != nil {
+		t.Error("must be nil.")
+	}
+	if Bind(ctx, PredicateCtx[int](nil)) != nil {
+		t.Error("must be nil.")
+	}
+}
+
+func TestRecover(t *testing.T) {
+	p := Recover(func(i int) (bool, error) {
+		if i == 0 {
+			panic("zero")
+		}
+		return true, nil
+	})
+	checkPredicate(t, p, 1, true)
+	ok, err := p(0)
+	var pe *function.PanicError
+	if !errors.As(err, &pe) {
+		t.Fatalf("must be PanicError but %q.", err)
+	}
+	if ok {
+		t.Error("must return false.")
+	}
+	if Recover(Predicate[int](nil)) != nil {
+		t.Error("must be nil.")
+	}
+}
+
+func TestAdjustE(t *testing.T) {
+	if _, err := AdjustE[any, any](nil); !errors.Is(err, function.ErrNilFunctional) {
+		t.Errorf("must be ErrNilFunctional but %v.", err)
+	}
+	_, err := AdjustE[*bytes.Buffer](func(io.Closer) (bool, error) { return true, nil })
+	if !errors.Is(err, function.ErrFailToCast) {
+		t.Fatalf("must be ErrFailToCast but %v.", err)
+	}
+	if want := "*bytes.Buffer does not implement io.Closer"; err.Error() != want {
+		t.Errorf("want=%q, got=%q", want, err.Error())
+	}
+	p, err := AdjustE[*bytes.Buffer](func(io.Writer) (bool, error) { return true, nil })
+	if err != nil {
+		t.Fatalf("must not return err: %s", err)
+	}
+	checkPredicate(t, p, &bytes.Buffer{}, true)
+}
@@ -0,0 +1,51 @@
+package predicate
+
+import "iter"
+
+// FilterSeq returns a sequence which yields the elements of seq
+// matching p with nil error. If p returns error, the sequence yields
+// the element and the error, then stops.
+// FilterSeq returns nil if seq or p is nil.
+func FilterSeq[T any](seq iter.Seq[T], p Predicate[T]) iter.Seq2[T, error] {
+	if seq == nil || p == nil {
+		return nil
+	}
+	return func(yield func(T, error) bool) {
+		for in := range seq {
+			ok, err := p(in)
+			if err != nil {
+				yield(in, err)
+				return
+			}
+			if ok && !yield(in, nil) {
+				return
+			}
+		}
+	}
+}
+
+// FilterSeq2 is the same as [FilterSeq] but seq yields each element
+// with an error. If seq yields an error, the sequence yields the
+// element and the error without calling p, then stops.
+// FilterSeq2 returns nil if seq or p is nil.
+func FilterSeq2[T any](seq iter.Seq2[T, error], p Predicate[T]) iter.Seq2[T, error] {
+	if seq == nil || p == nil {
+		return nil
+	}
+	return func(yield func(T, error) bool) {
+		for in, err := range seq {
+			if err != nil {
+				yield(in, err)
+				return
+			}
+			ok, err := p(in)
+			if err != nil {
+				yield(in, err)
+				return
+			}
+			if ok && !yield(in, nil) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package predicate
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func collect[T any](t *testing.T, seq func(func(T, error) bool)) ([]T, error) {
+	t.Helper()
+	var ret []T
+	for v, err := range seq {
+		if err != nil {
+			return ret, err
+		}
+		ret = append(ret, v)
+	}
+	return ret, nil
+}
+
+func TestFilterSeq(t *testing.T) {
+	even := wnep(func(i int) bool { return i%2 == 0 })
+	got, err := collect(t, FilterSeq(slices.Values([]int{1, 2, 3, 4}), even))
+	if err != nil {
+		t.Fatalf("must not return error: %s", err)
+	}
+	if diff := cmp.Diff([]int{2, 4}, got); diff != "" {
+		t.Error(diff)
+	}
+
+	want := errors.New("foo")
+	failing := func(i int) (bool, error) {
+		if i == 3 {
+			return false, want
+		}
+		return true, nil
+	}
+	got, err = collect(t, FilterSeq(slices.Values([]int{1, 2, 3, 4}), failing))
+	if err != want {
+		t.Fatalf("want=%q, got=%q", want, err)
+	}
+	if diff := cmp.Diff([]int{1, 2}, got); diff != "" {
+		t.Error(diff)
+	}
+
+	if FilterSeq(nil, even) != nil {
+		t.Error("must be nil.")
+	}
+	if FilterSeq(slices.Values([]int{1}), nil) != nil {
+		t.Error("must be nil.")
+	}
+}
+
+func TestFilterSeq2(t *testing.T) {
+	even := wnep(func(i int) bool { return i%2 == 0 })
+	positive := wnep(func(i int) bool { return i > 0 })
+	got, err := collect(t, FilterSeq2(FilterSeq(slices.Values([]int{-2, 1, 2, 3, 4}), positive), even))
+	if err != nil {
+		t.Fatalf("must not return error: %s", err)
+	}
+	if diff := cmp.Diff([]int{2, 4}, got); diff != "" {
+		t.Error(diff)
+	}
+
+	want := errors.New("foo")
+	failing := FilterSeq(slices.Values([]int{2, 3, 4}), func(i int) (bool, error) {
+		if i == 3 {
+			return false, want
+		}
+		return true, nil
+	})
+	got, err = collect(t, FilterSeq2(failing, even))
+	if err != want {
+		t.Fatalf("want=%q, got=%q", want, err)
+	}
+	if diff := cmp.Diff([]int{2}, got); diff != "" {
+		t.Error(diff)
+	}
+
+	if FilterSeq2(nil, even) != nil {
+		t.Error("must be nil.")
+	}
+}
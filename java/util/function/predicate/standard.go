@@ -0,0 +1,102 @@
+package predicate
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// InRange returns an [Expr] which tests an argument is between lo
+// and hi. If inclusive is true, lo and hi are in the range. The
+// Expr is rendered as "in [lo, hi]" or "in (lo, hi)" by
+// [Expr.String].
+func InRange[T cmp.Ordered](lo, hi T, inclusive bool) *Expr[T] {
+	if inclusive {
+		return Named(fmt.Sprintf("in [%v, %v]", lo, hi),
+			WrapNoErr(func(in T) bool { return lo <= in && in <= hi }))
+	}
+	return Named(fmt.Sprintf("in (%v, %v)", lo, hi),
+		WrapNoErr(func(in T) bool { return lo < in && in < hi }))
+}
+
+// OneOf returns an [Expr] which tests an argument is one of values.
+// The Expr looks up a set built from values, so it does not
+// depend on the number of values. The Expr is rendered as
+// "one of [values...]" by [Expr.String].
+func OneOf[T comparable](values ...T) *Expr[T] {
+	set := make(map[T]struct{}, len(values))
+	for _, v := range values {
+		set[v] = struct{}{}
+	}
+	return Named(fmt.Sprintf("one of %v", values), WrapNoErr(func(in T) bool {
+		_, ok := set[in]
+		return ok
+	}))
+}
+
+// MatchesRegexp returns an [Expr] which tests an argument matches
+// re. The Expr is rendered as "matches `re`" by
+// [Expr.String].
+// If re is nil, this function returns nil.
+func MatchesRegexp(re *regexp.Regexp) *Expr[string] {
+	if re == nil {
+		return nil
+	}
+	return Named("matches `"+re.String()+"`", WrapNoErr(re.MatchString))
+}
+
+// HasPrefix returns an [Expr] which tests an argument begins with
+// prefix. The Expr is rendered as `has prefix "prefix"` by
+// [Expr.String].
+func HasPrefix(prefix string) *Expr[string] {
+	return Named(fmt.Sprintf("has prefix %q", prefix),
+		WrapNoErr(func(in string) bool { return strings.HasPrefix(in, prefix) }))
+}
+
+// HasSuffix returns an [Expr] which tests an argument ends with
+// suffix. The Expr is rendered as `has suffix "suffix"` by
+// [Expr.String].
+func HasSuffix(suffix string) *Expr[string] {
+	return Named(fmt.Sprintf("has suffix %q", suffix),
+		WrapNoErr(func(in string) bool { return strings.HasSuffix(in, suffix) }))
+}
+
+// Contains returns an [Expr] which tests an argument contains
+// substr. The Expr is rendered as `contains "substr"` by
+// [Expr.String].
+func Contains(substr string) *Expr[string] {
+	return Named(fmt.Sprintf("contains %q", substr),
+		WrapNoErr(func(in string) bool { return strings.Contains(in, substr) }))
+}
+
+// LenBetween returns an [Expr] which tests the length of an
+// argument is between lo and hi inclusive. T must be a type which
+// has length such as strings, slices and maps. The length of a string
+// is the number of bytes as len. The Expr is rendered as
+// "len in [lo, hi]" by [Expr.String].
+// If T does not have length, this function returns nil.
+func LenBetween[T any](lo, hi int) *Expr[T] {
+	switch reflect.TypeFor[T]().Kind() {
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array, reflect.Chan:
+	default:
+		return nil
+	}
+	return Named(fmt.Sprintf("len in [%d, %d]", lo, hi), WrapNoErr(func(in T) bool {
+		l := reflect.ValueOf(&in).Elem().Len()
+		return lo <= l && l <= hi
+	}))
+}
+
+// ErrorIs returns an [Expr] which tests an error matches target by
+// [errors.Is]. The Expr is rendered as `error is "target"` by
+// [Expr.String].
+func ErrorIs(target error) *Expr[error] {
+	name := "error is nil"
+	if target != nil {
+		name = fmt.Sprintf("error is %q", target.Error())
+	}
+	return Named(name, WrapNoErr(func(in error) bool { return errors.Is(in, target) }))
+}
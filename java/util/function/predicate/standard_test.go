@@ -0,0 +1,102 @@
+package predicate
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"testing"
+)
+
+func TestInRange(t *testing.T) {
+	p1 := InRange(1, 3, true)
+	checkExpr(t, p1, "in [1, 3]")
+	checkPredicate(t, p1.Test, 1, true)
+	checkPredicate(t, p1.Test, 3, true)
+	checkPredicate(t, p1.Test, 4, false)
+
+	p2 := InRange("b", "d", false)
+	checkExpr(t, p2, "in (b, d)")
+	checkPredicate(t, p2.Test, "b", false)
+	checkPredicate(t, p2.Test, "c", true)
+	checkPredicate(t, p2.Test, "d", false)
+}
+
+func TestOneOf(t *testing.T) {
+	p := OneOf("a", "b")
+	checkExpr(t, p, "one of [a b]")
+	checkPredicate(t, p.Test, "a", true)
+	checkPredicate(t, p.Test, "c", false)
+	checkPredicate(t, OneOf[int]().Test, 0, false)
+}
+
+func TestStringPredicates(t *testing.T) {
+	p1 := MatchesRegexp(regexp.MustCompile(`^[a-z]+$`))
+	checkExpr(t, p1, "matches `^[a-z]+$`")
+	checkPredicate(t, p1.Test, "abc", true)
+	checkPredicate(t, p1.Test, "ABC", false)
+	if MatchesRegexp(nil) != nil {
+		t.Error("must be nil.")
+	}
+
+	p2 := HasPrefix("foo")
+	checkExpr(t, p2, `has prefix "foo"`)
+	checkPredicate(t, p2.Test, "foobar", true)
+	checkPredicate(t, p2.Test, "barfoo", false)
+
+	p3 := HasSuffix("foo")
+	checkExpr(t, p3, `has suffix "foo"`)
+	checkPredicate(t, p3.Test, "barfoo", true)
+	checkPredicate(t, p3.Test, "foobar", false)
+
+	p4 := Contains("oo")
+	checkExpr(t, p4, `contains "oo"`)
+	checkPredicate(t, p4.Test, "foo", true)
+	checkPredicate(t, p4.Test, "bar", false)
+}
+
+func TestLenBetween(t *testing.T) {
+	p1 := LenBetween[string](1, 3)
+	checkExpr(t, p1, "len in [1, 3]")
+	checkPredicate(t, p1.Test, "", false)
+	checkPredicate(t, p1.Test, "abc", true)
+	checkPredicate(t, p1.Test, "abcd", false)
+
+	p2 := LenBetween[[]int](0, 1)
+	checkPredicate(t, p2.Test, nil, true)
+	checkPredicate(t, p2.Test, []int{1, 2}, false)
+
+	p3 := LenBetween[map[string]int](1, 1)
+	checkPredicate(t, p3.Test, map[string]int{"a": 1}, true)
+	checkPredicate(t, p3.Test, map[string]int{}, false)
+
+	if LenBetween[int](0, 1) != nil {
+		t.Error("must be nil.")
+	}
+}
+
+func TestErrorIs(t *testing.T) {
+	p1 := ErrorIs(io.EOF)
+	checkExpr(t, p1, `error is "EOF"`)
+	checkPredicate(t, p1.Test, fmt.Errorf("wrapped: %w", io.EOF), true)
+	checkPredicate(t, p1.Test, errors.New("EOF"), false)
+
+	p2 := ErrorIs(nil)
+	checkExpr(t, p2, "error is nil")
+	checkPredicate(t, p2.Test, nil, true)
+	checkPredicate(t, p2.Test, io.EOF, false)
+}
+
+func TestStandardCombined(t *testing.T) {
+	username := LenBetween[string](3, 8).And(MatchesRegexp(regexp.MustCompile(`^[a-z]+$`)).Or(HasPrefix("_")))
+	checkExpr(t, username, "(len in [3, 8] AND (matches `^[a-z]+$` OR has prefix \"_\"))")
+	checkPredicate(t, username.Test, "alice", true)
+	checkPredicate(t, username.Test, "_Bob", true)
+	checkPredicate(t, username.Test, "Bob", false)
+	checkPredicate(t, username.Test, "al", false)
+
+	tr := Explain(username, "Bob")
+	if tr.Result || len(tr.Children) != 2 || tr.Children[1].Children[0].Result {
+		t.Errorf("unexpected trace: %s", tr)
+	}
+}
@@ -0,0 +1,344 @@
+//go:build ignore
+
+// gen.go generates the primitive specializations for int, int64 and
+// float64. Run "go generate" in this directory after editing it.
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"log"
+	"os"
+	"strings"
+	"text/template"
+)
+
+type primitive struct {
+	Name string // Java name like Int
+	Type string // Go type like int
+}
+
+type data struct {
+	primitive
+	Others []primitive
+}
+
+var primitives = []primitive{
+	{"Int", "int"},
+	{"Long", "int64"},
+	{"Double", "float64"},
+}
+
+var tmpl = template.Must(template.New("").Parse(`// Code generated by gen.go; DO NOT EDIT.
+
+package primitive
+
+// {{.Name}}Function is a port of java.util.function.{{.Name}}Function.
+// It represents a function that accepts {{.Type}} and produce one
+// result and error.
+type {{.Name}}Function[R any] func({{.Type}}) (R, error)
+
+// Wrap{{.Name}}Function adjusts a function that accepts {{.Type}} and
+// produce one result to {{.Name}}Function.
+// If f is nil, this function returns nil.
+func Wrap{{.Name}}Function[R any](f func({{.Type}}) R) {{.Name}}Function[R] {
+	if f == nil {
+		return nil
+	}
+	return func(in {{.Type}}) (R, error) { return f(in), nil }
+}
+{{range .Others}}
+// {{$.Name}}To{{.Name}}Function is a port of
+// java.util.function.{{$.Name}}To{{.Name}}Function. It represents a
+// function that accepts {{$.Type}} and produce {{.Type}} and error.
+type {{$.Name}}To{{.Name}}Function func({{$.Type}}) ({{.Type}}, error)
+
+// Wrap{{$.Name}}To{{.Name}}Function adjusts a function that accepts
+// {{$.Type}} and produce {{.Type}} to {{$.Name}}To{{.Name}}Function.
+// If f is nil, this function returns nil.
+func Wrap{{$.Name}}To{{.Name}}Function(f func({{$.Type}}) {{.Type}}) {{$.Name}}To{{.Name}}Function {
+	if f == nil {
+		return nil
+	}
+	return func(in {{$.Type}}) ({{.Type}}, error) { return f(in), nil }
+}
+{{end}}
+// To{{.Name}}Function is a port of java.util.function.To{{.Name}}Function.
+// It represents a function that accepts one argument and produce
+// {{.Type}} and error.
+type To{{.Name}}Function[T any] func(T) ({{.Type}}, error)
+
+// WrapTo{{.Name}}Function adjusts a function that accepts one argument
+// and produce {{.Type}} to To{{.Name}}Function.
+// If f is nil, this function returns nil.
+func WrapTo{{.Name}}Function[T any](f func(T) {{.Type}}) To{{.Name}}Function[T] {
+	if f == nil {
+		return nil
+	}
+	return func(in T) ({{.Type}}, error) { return f(in), nil }
+}
+
+// To{{.Name}}BiFunction is a port of
+// java.util.function.To{{.Name}}BiFunction. It represents a function
+// that accepts two arguments and produce {{.Type}} and error.
+type To{{.Name}}BiFunction[T, U any] func(T, U) ({{.Type}}, error)
+
+// WrapTo{{.Name}}BiFunction adjusts a function that accepts two
+// arguments and produce {{.Type}} to To{{.Name}}BiFunction.
+// If f is nil, this function returns nil.
+func WrapTo{{.Name}}BiFunction[T, U any](f func(T, U) {{.Type}}) To{{.Name}}BiFunction[T, U] {
+	if f == nil {
+		return nil
+	}
+	return func(t T, u U) ({{.Type}}, error) { return f(t, u), nil }
+}
+
+// {{.Name}}Predicate is a port of java.util.function.{{.Name}}Predicate.
+// It represents a function that accepts {{.Type}} and produce one bool
+// result and error.
+type {{.Name}}Predicate func({{.Type}}) (bool, error)
+
+// Wrap{{.Name}}Predicate adjusts a function that accepts {{.Type}} and
+// produce one bool result to {{.Name}}Predicate.
+// If f is nil, this function returns nil.
+func Wrap{{.Name}}Predicate(f func({{.Type}}) bool) {{.Name}}Predicate {
+	if f == nil {
+		return nil
+	}
+	return func(in {{.Type}}) (bool, error) { return f(in), nil }
+}
+
+func new{{.Name}}Predicates(p {{.Name}}Predicate, others []{{.Name}}Predicate) []{{.Name}}Predicate {
+	if p == nil {
+		return nil
+	}
+	for _, o := range others {
+		if o == nil {
+			return nil
+		}
+	}
+	return append([]{{.Name}}Predicate{p}, others...)
+}
+
+// And returns a {{.Name}}Predicate which is a short-circuiting logical
+// AND of p and others in this order. If preceding predicates return
+// false or error, rest of predicates are not evaluated.
+// And returns nil if p or one of others is nil.
+func (p {{.Name}}Predicate) And(others ...{{.Name}}Predicate) {{.Name}}Predicate {
+	ps := new{{.Name}}Predicates(p, others)
+	if ps == nil {
+		return nil
+	}
+	return func(in {{.Type}}) (bool, error) {
+		for _, p := range ps {
+			ok, err := p(in)
+			if err != nil {
+				return false, err
+			}
+			if !ok {
+				return false, nil
+			}
+		}
+		return true, nil
+	}
+}
+
+// Or returns a {{.Name}}Predicate which is a short-circuiting logical
+// OR of p and others in this order. If preceding predicates return
+// true or error, rest of predicates are not evaluated.
+// Or returns nil if p or one of others is nil.
+func (p {{.Name}}Predicate) Or(others ...{{.Name}}Predicate) {{.Name}}Predicate {
+	ps := new{{.Name}}Predicates(p, others)
+	if ps == nil {
+		return nil
+	}
+	return func(in {{.Type}}) (bool, error) {
+		for _, p := range ps {
+			ok, err := p(in)
+			if err != nil {
+				return false, err
+			}
+			if ok {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+}
+
+// Negate returns a {{.Name}}Predicate which returns negation of p.
+// If p is nil, this function returns nil.
+func (p {{.Name}}Predicate) Negate() {{.Name}}Predicate {
+	if p == nil {
+		return nil
+	}
+	return func(in {{.Type}}) (bool, error) {
+		ok, err := p(in)
+		if err != nil {
+			return false, err
+		}
+		return !ok, nil
+	}
+}
+
+// {{.Name}}UnaryOperator is a port of
+// java.util.function.{{.Name}}UnaryOperator. It represents an operation
+// on {{.Type}} that produces {{.Type}} and error.
+type {{.Name}}UnaryOperator func({{.Type}}) ({{.Type}}, error)
+
+// Wrap{{.Name}}UnaryOperator adjusts a function that accepts {{.Type}}
+// and produce {{.Type}} to {{.Name}}UnaryOperator.
+// If f is nil, this function returns nil.
+func Wrap{{.Name}}UnaryOperator(f func({{.Type}}) {{.Type}}) {{.Name}}UnaryOperator {
+	if f == nil {
+		return nil
+	}
+	return func(in {{.Type}}) ({{.Type}}, error) { return f(in), nil }
+}
+
+// {{.Name}}Identity generate a {{.Name}}UnaryOperator which always
+// returns its input argument.
+func {{.Name}}Identity() {{.Name}}UnaryOperator {
+	return func(in {{.Type}}) ({{.Type}}, error) { return in, nil }
+}
+
+// AndThen returns a {{.Name}}UnaryOperator which applies o and then
+// after to the result. If o returns error, after is not evaluated.
+// AndThen returns nil if o or after is nil.
+func (o {{.Name}}UnaryOperator) AndThen(after {{.Name}}UnaryOperator) {{.Name}}UnaryOperator {
+	if o == nil || after == nil {
+		return nil
+	}
+	return func(in {{.Type}}) ({{.Type}}, error) {
+		v, err := o(in)
+		if err != nil {
+			return 0, err
+		}
+		return after(v)
+	}
+}
+
+// Compose returns a {{.Name}}UnaryOperator which applies before and
+// then o to the result. Compose returns nil if o or before is nil.
+func (o {{.Name}}UnaryOperator) Compose(before {{.Name}}UnaryOperator) {{.Name}}UnaryOperator {
+	return before.AndThen(o)
+}
+
+// {{.Name}}BinaryOperator is a port of
+// java.util.function.{{.Name}}BinaryOperator. It represents an operation
+// upon two {{.Type}} operands producing {{.Type}} and error.
+type {{.Name}}BinaryOperator func({{.Type}}, {{.Type}}) ({{.Type}}, error)
+
+// Wrap{{.Name}}BinaryOperator adjusts a function that accepts two
+// {{.Type}} arguments and produce {{.Type}} to {{.Name}}BinaryOperator.
+// If f is nil, this function returns nil.
+func Wrap{{.Name}}BinaryOperator(f func({{.Type}}, {{.Type}}) {{.Type}}) {{.Name}}BinaryOperator {
+	if f == nil {
+		return nil
+	}
+	return func(a, b {{.Type}}) ({{.Type}}, error) { return f(a, b), nil }
+}
+
+// {{.Name}}Consumer is a port of java.util.function.{{.Name}}Consumer.
+// It represents a function that accepts {{.Type}} and returns error.
+type {{.Name}}Consumer func({{.Type}}) error
+
+// Wrap{{.Name}}Consumer adjusts a function that accepts {{.Type}} and
+// no return to {{.Name}}Consumer.
+// If f is nil, this function returns nil.
+func Wrap{{.Name}}Consumer(f func({{.Type}})) {{.Name}}Consumer {
+	if f == nil {
+		return nil
+	}
+	return func(in {{.Type}}) error {
+		f(in)
+		return nil
+	}
+}
+
+func new{{.Name}}Consumers(c {{.Name}}Consumer, others []{{.Name}}Consumer) []{{.Name}}Consumer {
+	if c == nil {
+		return nil
+	}
+	for _, o := range others {
+		if o == nil {
+			return nil
+		}
+	}
+	return append([]{{.Name}}Consumer{c}, others...)
+}
+
+// AndThen returns a {{.Name}}Consumer which evaluates c and then
+// after in this order. If preceding consumers return error, rest of
+// consumers are not evaluated.
+// AndThen returns nil if c or one of after is nil.
+func (c {{.Name}}Consumer) AndThen(after ...{{.Name}}Consumer) {{.Name}}Consumer {
+	cs := new{{.Name}}Consumers(c, after)
+	if cs == nil {
+		return nil
+	}
+	return func(in {{.Type}}) error {
+		for _, c := range cs {
+			if err := c(in); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// Obj{{.Name}}Consumer is a port of
+// java.util.function.Obj{{.Name}}Consumer. It represents a function that
+// accepts one argument and {{.Type}} and returns error.
+type Obj{{.Name}}Consumer[T any] func(T, {{.Type}}) error
+
+// WrapObj{{.Name}}Consumer adjusts a function that accepts one
+// argument and {{.Type}} and no return to Obj{{.Name}}Consumer.
+// If f is nil, this function returns nil.
+func WrapObj{{.Name}}Consumer[T any](f func(T, {{.Type}})) Obj{{.Name}}Consumer[T] {
+	if f == nil {
+		return nil
+	}
+	return func(t T, v {{.Type}}) error {
+		f(t, v)
+		return nil
+	}
+}
+
+// {{.Name}}Supplier is a port of java.util.function.{{.Name}}Supplier.
+// It represents a function that accepts no argument and produces
+// {{.Type}} and error.
+type {{.Name}}Supplier func() ({{.Type}}, error)
+
+// Wrap{{.Name}}Supplier adjusts a function that accepts no argument and
+// produce {{.Type}} to {{.Name}}Supplier.
+// If f is nil, this function returns nil.
+func Wrap{{.Name}}Supplier(f func() {{.Type}}) {{.Name}}Supplier {
+	if f == nil {
+		return nil
+	}
+	return func() ({{.Type}}, error) { return f(), nil }
+}
+`))
+
+func main() {
+	for _, p := range primitives {
+		d := data{primitive: p}
+		for _, o := range primitives {
+			if o != p {
+				d.Others = append(d.Others, o)
+			}
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, d); err != nil {
+			log.Fatal(err)
+		}
+		src, err := format.Source(buf.Bytes())
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := os.WriteFile(strings.ToLower(p.Name)+".go", src, 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
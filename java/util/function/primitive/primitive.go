@@ -0,0 +1,31 @@
+// Package primitive is a port of the primitive specializations of
+// java.util.function such as IntFunction, ToLongFunction and
+// DoublePredicate. Java's int, long and double are int, int64 and
+// float64.
+//
+// The functional types follow the conventions of the packages under
+// java/util/function: each accepts the same arguments as Java and
+// additionally returns error. Since a package holds the types for
+// all primitives, the adjusting functions are named Wrap followed by
+// the type name instead of WrapNoErr, and the composing functions
+// are methods named after the default methods of Java.
+//
+//   - https://docs.oracle.com/en/java/javase/21/docs/api/java.base/java/util/function/package-summary.html
+package primitive
+
+//go:generate go run gen.go
+
+// BooleanSupplier is a port of java.util.function.BooleanSupplier.
+// It represents a function that accepts no argument and produces
+// bool and error.
+type BooleanSupplier func() (bool, error)
+
+// WrapBooleanSupplier adjusts a function that accepts no argument
+// and produce bool to BooleanSupplier.
+// If f is nil, this function returns nil.
+func WrapBooleanSupplier(f func() bool) BooleanSupplier {
+	if f == nil {
+		return nil
+	}
+	return func() (bool, error) { return f(), nil }
+}
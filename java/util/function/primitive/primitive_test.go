@@ -0,0 +1,154 @@
+package primitive
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func checkResult[T comparable](t *testing.T, got T, err error, want T) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("must not return error but %q.", err)
+	}
+	if got != want {
+		t.Errorf("want=%v, got=%v", want, got)
+	}
+}
+
+func TestWrap(t *testing.T) {
+	v, err := WrapIntFunction(strconv.Itoa)(1)
+	checkResult(t, v, err, "1")
+	l, err := WrapIntToLongFunction(func(i int) int64 { return int64(i) << 40 })(1)
+	checkResult(t, l, err, int64(1)<<40)
+	d, err := WrapLongToDoubleFunction(func(i int64) float64 { return float64(i) / 2 })(3)
+	checkResult(t, d, err, 1.5)
+	i, err := WrapDoubleToIntFunction(func(f float64) int { return int(math.Round(f)) })(1.6)
+	checkResult(t, i, err, 2)
+	i, err = WrapToIntFunction(func(s string) int { return len(s) })("foo")
+	checkResult(t, i, err, 3)
+	l, err = WrapToLongBiFunction(func(s string, n int) int64 { return int64(len(s) * n) })("foo", 2)
+	checkResult(t, l, err, int64(6))
+	i, err = WrapIntBinaryOperator(func(a, b int) int { return a + b })(1, 2)
+	checkResult(t, i, err, 3)
+	d, err = WrapDoubleSupplier(func() float64 { return 0.5 })()
+	checkResult(t, d, err, 0.5)
+	b, err := WrapBooleanSupplier(func() bool { return true })()
+	checkResult(t, b, err, true)
+
+	var got []string
+	err = WrapObjIntConsumer(func(s string, i int) { got = append(got, s+strconv.Itoa(i)) })("a", 1)
+	checkResult(t, err, nil, nil)
+	if !slices.Equal(got, []string{"a1"}) {
+		t.Errorf("want=%v, got=%v", []string{"a1"}, got)
+	}
+
+	if WrapIntFunction[string](nil) != nil || WrapIntToLongFunction(nil) != nil ||
+		WrapToIntFunction[string](nil) != nil || WrapToIntBiFunction[string, int](nil) != nil ||
+		WrapIntPredicate(nil) != nil || WrapIntUnaryOperator(nil) != nil ||
+		WrapIntBinaryOperator(nil) != nil || WrapIntConsumer(nil) != nil ||
+		WrapObjIntConsumer[string](nil) != nil || WrapIntSupplier(nil) != nil ||
+		WrapBooleanSupplier(nil) != nil {
+		t.Error("must be nil.")
+	}
+}
+
+func TestPredicate(t *testing.T) {
+	positive := WrapIntPredicate(func(i int) bool { return i > 0 })
+	even := WrapIntPredicate(func(i int) bool { return i%2 == 0 })
+	small := WrapIntPredicate(func(i int) bool { return i < 10 })
+
+	for _, tt := range []struct {
+		name string
+		p    IntPredicate
+		in   int
+		want bool
+	}{
+		{"And true", positive.And(even, small), 2, true},
+		{"And false", positive.And(even, small), 12, false},
+		{"And single", positive.And(), 1, true},
+		{"Or true", positive.Or(even), -2, true},
+		{"Or false", positive.Or(even), -1, false},
+		{"Negate", positive.Negate(), -1, true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.p(tt.in)
+			checkResult(t, got, err, tt.want)
+		})
+	}
+
+	want := errors.New("foo")
+	fail := IntPredicate(func(int) (bool, error) { return false, want })
+	notCalled := IntPredicate(func(int) (bool, error) {
+		t.Error("must not be called.")
+		return false, nil
+	})
+	if _, err := fail.And(notCalled)(1); err != want {
+		t.Errorf("want=%q, got=%q", want, err)
+	}
+	if _, err := fail.Or(notCalled)(1); err != want {
+		t.Errorf("want=%q, got=%q", want, err)
+	}
+	if _, err := fail.Negate()(1); err != want {
+		t.Errorf("want=%q, got=%q", want, err)
+	}
+
+	var nilPredicate IntPredicate
+	if nilPredicate.And(positive) != nil || positive.And(nil) != nil ||
+		nilPredicate.Or(positive) != nil || positive.Or(even, nil) != nil ||
+		nilPredicate.Negate() != nil {
+		t.Error("must be nil.")
+	}
+
+	nan := WrapDoublePredicate(math.IsNaN)
+	got, err := nan.Negate()(math.NaN())
+	checkResult(t, got, err, false)
+}
+
+func TestUnaryOperator(t *testing.T) {
+	inc := WrapLongUnaryOperator(func(i int64) int64 { return i + 1 })
+	double := WrapLongUnaryOperator(func(i int64) int64 { return i * 2 })
+
+	v, err := inc.AndThen(double)(1)
+	checkResult(t, v, err, int64(4))
+	v, err = inc.Compose(double)(1)
+	checkResult(t, v, err, int64(3))
+	v, err = LongIdentity().AndThen(inc)(1)
+	checkResult(t, v, err, int64(2))
+
+	want := errors.New("foo")
+	fail := LongUnaryOperator(func(int64) (int64, error) { return 0, want })
+	if _, err := fail.AndThen(inc)(1); err != want {
+		t.Errorf("want=%q, got=%q", want, err)
+	}
+	if inc.AndThen(nil) != nil || inc.Compose(nil) != nil || LongUnaryOperator(nil).AndThen(inc) != nil {
+		t.Error("must be nil.")
+	}
+}
+
+func TestConsumer(t *testing.T) {
+	var got []float64
+	record := WrapDoubleConsumer(func(f float64) { got = append(got, f) })
+	twice := WrapDoubleConsumer(func(f float64) { got = append(got, f*2) })
+	if err := record.AndThen(twice)(1.5); err != nil {
+		t.Fatalf("must not return error but %q.", err)
+	}
+	if want := []float64{1.5, 3}; !slices.Equal(got, want) {
+		t.Errorf("want=%v, got=%v", want, got)
+	}
+
+	want := errors.New("foo")
+	fail := DoubleConsumer(func(float64) error { return want })
+	got = nil
+	if err := fail.AndThen(record)(1); err != want {
+		t.Errorf("want=%q, got=%q", want, err)
+	}
+	if len(got) != 0 {
+		t.Errorf("rest of consumers must not be called: %v", got)
+	}
+	if record.AndThen(nil) != nil || DoubleConsumer(nil).AndThen(record) != nil {
+		t.Error("must be nil.")
+	}
+}
@@ -0,0 +1,214 @@
+// Package retry provides wrappers of functional types which retry
+// failing calls.
+//
+// A wrapper returns the same functional type as the wrapped one. It
+// calls the wrapped one until it succeeds, the number of attempts
+// reaches the limit or the error is not retryable. Between attempts,
+// it waits for the duration given by a [Backoff]. If all attempts
+// fail, the returned error joins the errors of every attempt with
+// [errors.Join].
+package retry
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"time"
+
+	"github.com/dairyo/j2g/java/lang/runnable"
+	"github.com/dairyo/j2g/java/util/function/function"
+	"github.com/dairyo/j2g/java/util/function/predicate"
+	"github.com/dairyo/j2g/java/util/function/supplier"
+)
+
+// DefaultMaxAttempts is the number of attempts if [WithMaxAttempts]
+// is not given.
+const DefaultMaxAttempts = 3
+
+// Backoff returns the duration to wait after the n-th attempt fails.
+// n starts from 1.
+type Backoff func(n int) time.Duration
+
+// Fixed returns a [Backoff] which always waits for d.
+func Fixed(d time.Duration) Backoff {
+	return func(int) time.Duration { return d }
+}
+
+// Exponential returns a [Backoff] which waits for initial after the
+// first attempt and multiplies the duration by factor after each
+// attempt. The duration does not exceed limit if limit is positive,
+// and never exceeds the maximum [time.Duration] otherwise. If factor
+// is less than 1, it is treated as 1 so that the duration never
+// shrinks.
+func Exponential(initial time.Duration, factor float64, limit time.Duration) Backoff {
+	if limit <= 0 {
+		limit = math.MaxInt64
+	}
+	factor = max(factor, 1)
+	return func(n int) time.Duration {
+		d := float64(initial)
+		for i := 1; i < n && d < float64(limit); i++ {
+			d *= factor
+		}
+		// float64(limit) may be rounded up from limit, so limit is
+		// returned as is instead of converting d.
+		if d >= float64(limit) {
+			return limit
+		}
+		return time.Duration(d)
+	}
+}
+
+// Jitter returns a [Backoff] which waits for a random duration
+// between 0 and the duration given by b, known as full jitter.
+// random returns a random number in [0.0, 1.0). If random is nil,
+// [rand.Float64] is used.
+// If b is nil, this function returns nil.
+func Jitter(b Backoff, random func() float64) Backoff {
+	if b == nil {
+		return nil
+	}
+	if random == nil {
+		random = rand.Float64
+	}
+	return func(n int) time.Duration {
+		return time.Duration(float64(b(n)) * random())
+	}
+}
+
+// Option configures the retry policy.
+type Option func(*policy)
+
+type policy struct {
+	maxAttempts int
+	backoff     Backoff
+	retryable   predicate.Predicate[error]
+	sleep       func(time.Duration)
+}
+
+// WithMaxAttempts limits the number of attempts including the first
+// one to n. If n is less than 1, only one attempt is made.
+func WithMaxAttempts(n int) Option {
+	return func(p *policy) { p.maxAttempts = max(n, 1) }
+}
+
+// WithBackoff sets the [Backoff] between attempts. By default, the
+// wrappers do not wait.
+func WithBackoff(b Backoff) Option {
+	return func(p *policy) { p.backoff = b }
+}
+
+// WithRetryIf sets a classifier of errors. An error is retried only
+// if p returns true. If p returns error, retrying stops and the
+// error is joined to the returned error. By default, all errors are
+// retried.
+func WithRetryIf(p predicate.Predicate[error]) Option {
+	return func(pl *policy) { pl.retryable = p }
+}
+
+// WithSleep sets the function to wait between attempts. The default
+// is [time.Sleep]. Tests can inject a function which records the
+// durations instead of sleeping. If sleep is nil, the default is
+// used.
+func WithSleep(sleep func(time.Duration)) Option {
+	return func(p *policy) {
+		if sleep != nil {
+			p.sleep = sleep
+		}
+	}
+}
+
+func newPolicy(opts []Option) *policy {
+	p := &policy{maxAttempts: DefaultMaxAttempts, sleep: time.Sleep}
+	for _, o := range opts {
+		o(p)
+	}
+	return p
+}
+
+// do calls f until it succeeds or p gives up.
+func (p *policy) do(f func() error) error {
+	var errs []error
+	for n := 1; ; n++ {
+		err := f()
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, fmt.Errorf("attempt %d: %w", n, err))
+		if n >= p.maxAttempts {
+			return errors.Join(errs...)
+		}
+		if p.retryable != nil {
+			ok, cerr := p.retryable(err)
+			if cerr != nil {
+				return errors.Join(append(errs, cerr)...)
+			}
+			if !ok {
+				return errors.Join(errs...)
+			}
+		}
+		if p.backoff != nil {
+			p.sleep(p.backoff(n))
+		}
+	}
+}
+
+// Supplier returns a [supplier.Supplier] which calls s with retries
+// configured by opts.
+// If s is nil, this function returns nil.
+func Supplier[T any](s supplier.Supplier[T], opts ...Option) supplier.Supplier[T] {
+	if s == nil {
+		return nil
+	}
+	p := newPolicy(opts)
+	return func() (T, error) {
+		var ret T
+		err := p.do(func() error {
+			var err error
+			ret, err = s()
+			return err
+		})
+		if err != nil {
+			var zero T
+			return zero, err
+		}
+		return ret, nil
+	}
+}
+
+// Function returns a [function.Function] which calls f with retries
+// configured by opts.
+// If f is nil, this function returns nil.
+func Function[T, U any](f function.Function[T, U], opts ...Option) function.Function[T, U] {
+	if f == nil {
+		return nil
+	}
+	p := newPolicy(opts)
+	return func(in T) (U, error) {
+		var ret U
+		err := p.do(func() error {
+			var err error
+			ret, err = f(in)
+			return err
+		})
+		if err != nil {
+			var zero U
+			return zero, err
+		}
+		return ret, nil
+	}
+}
+
+// Runnable returns a [runnable.Runnable] which calls r with retries
+// configured by opts.
+// If r is nil, this function returns nil.
+func Runnable(r runnable.Runnable, opts ...Option) runnable.Runnable {
+	if r == nil {
+		return nil
+	}
+	p := newPolicy(opts)
+	return func() error {
+		return p.do(r)
+	}
+}
@@ -0,0 +1,188 @@
+package retry
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/dairyo/j2g/java/util/function/predicate"
+)
+
+var (
+	errTemporary = errors.New("temporary")
+	errFatal     = errors.New("fatal")
+)
+
+// flaky returns a function which fails with errs in order and
+// succeeds after that. It also returns a pointer to the number of
+// calls.
+func flaky(errs ...error) (func() error, *int) {
+	n := 0
+	return func() error {
+		n++
+		if n <= len(errs) {
+			return errs[n-1]
+		}
+		return nil
+	}, &n
+}
+
+// recordSleep returns an Option recording durations to sleep.
+func recordSleep(d *[]time.Duration) Option {
+	return WithSleep(func(v time.Duration) { *d = append(*d, v) })
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		name string
+		b    Backoff
+		want []time.Duration
+	}{
+		{"Fixed", Fixed(time.Second), []time.Duration{time.Second, time.Second, time.Second}},
+		{"Exponential", Exponential(time.Second, 2, 0), []time.Duration{time.Second, 2 * time.Second, 4 * time.Second}},
+		{"Exponential with limit", Exponential(time.Second, 3, 5*time.Second), []time.Duration{time.Second, 3 * time.Second, 5 * time.Second}},
+		{"Exponential with factor less than 1", Exponential(time.Second, 0.5, 0), []time.Duration{time.Second, time.Second, time.Second}},
+		{"Jitter", Jitter(Fixed(time.Second), func() float64 { return 0.5 }), []time.Duration{time.Second / 2, time.Second / 2, time.Second / 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []time.Duration
+			for n := 1; n <= len(tt.want); n++ {
+				got = append(got, tt.b(n))
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("want=%v, got=%v", tt.want, got)
+			}
+		})
+	}
+	for _, n := range []int{35, 64, 1000} {
+		if d := Exponential(time.Second, 2, 0)(n); d != math.MaxInt64 {
+			t.Errorf("want=%v, got=%v", time.Duration(math.MaxInt64), d)
+		}
+		if d := Exponential(time.Second, 2, time.Minute)(n); d != time.Minute {
+			t.Errorf("want=%v, got=%v", time.Minute, d)
+		}
+	}
+	if Jitter(nil, nil) != nil {
+		t.Error("must be nil.")
+	}
+	if d := Jitter(Fixed(time.Second), nil)(1); d < 0 || d >= time.Second {
+		t.Errorf("must be in [0, 1s) but %v.", d)
+	}
+}
+
+func TestRunnable(t *testing.T) {
+	t.Run("succeeds after retries", func(t *testing.T) {
+		f, n := flaky(errTemporary, errTemporary)
+		var slept []time.Duration
+		r := Runnable(f, WithBackoff(Exponential(time.Second, 2, 0)), recordSleep(&slept))
+		if err := r(); err != nil {
+			t.Fatalf("must not return error but %q.", err)
+		}
+		if *n != 3 {
+			t.Errorf("want=%d attempts, got=%d attempts", 3, *n)
+		}
+		if want := []time.Duration{time.Second, 2 * time.Second}; !slices.Equal(slept, want) {
+			t.Errorf("want=%v, got=%v", want, slept)
+		}
+	})
+
+	t.Run("gives up", func(t *testing.T) {
+		e1, e2 := errors.New("e1"), errors.New("e2")
+		f, n := flaky(e1, e2, errTemporary)
+		err := Runnable(f, WithMaxAttempts(2))()
+		if *n != 2 {
+			t.Errorf("want=%d attempts, got=%d attempts", 2, *n)
+		}
+		if !errors.Is(err, e1) || !errors.Is(err, e2) {
+			t.Errorf("error must join errors of all attempts but %q.", err)
+		}
+		if got, want := err.Error(), "attempt 1: e1\nattempt 2: e2"; got != want {
+			t.Errorf("want=%q, got=%q", want, got)
+		}
+	})
+
+	t.Run("default attempts", func(t *testing.T) {
+		f, n := flaky(errTemporary, errTemporary, errTemporary, errTemporary)
+		Runnable(f)()
+		if *n != DefaultMaxAttempts {
+			t.Errorf("want=%d attempts, got=%d attempts", DefaultMaxAttempts, *n)
+		}
+		f, n = flaky(errTemporary)
+		Runnable(f, WithMaxAttempts(0))()
+		if *n != 1 {
+			t.Errorf("want=%d attempts, got=%d attempts", 1, *n)
+		}
+	})
+
+	t.Run("not retryable", func(t *testing.T) {
+		f, n := flaky(errTemporary, errFatal, errTemporary)
+		retryable := predicate.WrapNoErr(func(err error) bool { return errors.Is(err, errTemporary) })
+		err := Runnable(f, WithMaxAttempts(5), WithRetryIf(retryable))()
+		if *n != 2 {
+			t.Errorf("want=%d attempts, got=%d attempts", 2, *n)
+		}
+		if !errors.Is(err, errFatal) || !errors.Is(err, errTemporary) {
+			t.Errorf("error must join errors of all attempts but %q.", err)
+		}
+	})
+
+	t.Run("classifier error", func(t *testing.T) {
+		f, n := flaky(errTemporary, errTemporary)
+		want := errors.New("classifier")
+		err := Runnable(f, WithRetryIf(func(error) (bool, error) { return false, want }))()
+		if *n != 1 {
+			t.Errorf("want=%d attempts, got=%d attempts", 1, *n)
+		}
+		if !errors.Is(err, want) || !errors.Is(err, errTemporary) {
+			t.Errorf("error must contain %q and %q but %q.", want, errTemporary, err)
+		}
+	})
+
+	if Runnable(nil) != nil {
+		t.Error("must be nil.")
+	}
+}
+
+func TestSupplier(t *testing.T) {
+	f, n := flaky(errTemporary)
+	s := Supplier(func() (int, error) {
+		if err := f(); err != nil {
+			return -1, err
+		}
+		return *n, nil
+	}, WithBackoff(Fixed(time.Second)), WithSleep(func(time.Duration) {}))
+	if got, err := s(); got != 2 || err != nil {
+		t.Errorf("want=%d, got=%d, %v", 2, got, err)
+	}
+	got, err := Supplier(func() (int, error) { return -1, errFatal }, WithMaxAttempts(1))()
+	if got != 0 || !errors.Is(err, errFatal) {
+		t.Errorf("must return zero value and %q but %d, %q.", errFatal, got, err)
+	}
+	if Supplier[int](nil) != nil {
+		t.Error("must be nil.")
+	}
+}
+
+func TestFunction(t *testing.T) {
+	f, _ := flaky(errTemporary)
+	var args []int
+	double := Function(func(i int) (int, error) {
+		args = append(args, i)
+		if err := f(); err != nil {
+			return 0, err
+		}
+		return i * 2, nil
+	})
+	if got, err := double(3); got != 6 || err != nil {
+		t.Errorf("want=%d, got=%d, %v", 6, got, err)
+	}
+	if want := []int{3, 3}; !slices.Equal(args, want) {
+		t.Errorf("want=%v, got=%v", want, args)
+	}
+	if Function[int, int](nil) != nil {
+		t.Error("must be nil.")
+	}
+}
@@ -1,5 +1,11 @@
 package supplier
 
+import (
+	"context"
+
+	"github.com/dairyo/j2g/java/util/function"
+)
+
 /**
 This is a port of java.util.function.Supplier.
 
@@ -22,3 +28,38 @@ func WrapNoErr[T any](f func() T) Supplier[T] {
 	}
 	return func() (T, error) { return f(), nil }
 }
+
+// Recover returns a Supplier which calls s and converts a panic in s
+// to a [*function.PanicError] returned as error.
+// If s is nil, this function returns nil.
+func Recover[T any](s Supplier[T]) Supplier[T] {
+	if s == nil {
+		return nil
+	}
+	return func() (ret T, err error) {
+		defer function.RecoverAsError(&err)
+		return s()
+	}
+}
+
+// SupplierCtx is a type to represents a function that accepts a
+// [context.Context] and produces one result and error.
+type SupplierCtx[T any] func(context.Context) (T, error)
+
+// WithContext adjusts a Supplier to SupplierCtx ignoring the context.
+// If s is nil, this function returns nil.
+func WithContext[T any](s Supplier[T]) SupplierCtx[T] {
+	if s == nil {
+		return nil
+	}
+	return func(context.Context) (T, error) { return s() }
+}
+
+// Bind returns a Supplier which calls s with ctx.
+// If s is nil, this function returns nil.
+func Bind[T any](ctx context.Context, s SupplierCtx[T]) Supplier[T] {
+	if s == nil {
+		return nil
+	}
+	return func() (T, error) { return s(ctx) }
+}
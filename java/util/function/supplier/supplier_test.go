@@ -1,6 +1,12 @@
 package supplier
 
-import "testing"
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dairyo/j2g/java/util/function"
+)
 
 func TestWrapNoErr(t *testing.T) {
 	if WrapNoErr[any](nil) != nil {
@@ -18,3 +24,42 @@ func TestWrapNoErr(t *testing.T) {
 		t.Errorf("want=0, got=%d", got)
 	}
 }
+
+func TestContext(t *testing.T) {
+	type key struct{}
+	ctx := context.WithValue(context.Background(), key{}, 1)
+
+	s1 := WithContext(WrapNoErr(func() int { return 1 }))
+	got, err := s1(ctx)
+	if err != nil || got != 1 {
+		t.Errorf("want=(1, nil), got=(%d, %v)", got, err)
+	}
+
+	s2 := Bind(ctx, func(ctx context.Context) (int, error) { return ctx.Value(key{}).(int), nil })
+	got, err = s2()
+	if err != nil || got != 1 {
+		t.Errorf("want=(1, nil), got=(%d, %v)", got, err)
+	}
+
+	if WithContext(Supplier[int](nil)) != nil {
+		t.Error("must be nil.")
+	}
+	if Bind(ctx, SupplierCtx[int](nil)) != nil {
+		t.Error("must be nil.")
+	}
+}
+
+func TestRecover(t *testing.T) {
+	s := Recover(func() (int, error) { panic("foo") })
+	got, err := s()
+	var pe *function.PanicError
+	if !errors.As(err, &pe) {
+		t.Fatalf("must be PanicError but %q.", err)
+	}
+	if got != 0 {
+		t.Errorf("want=0, got=%d", got)
+	}
+	if Recover(Supplier[int](nil)) != nil {
+		t.Error("must be nil.")
+	}
+}
@@ -0,0 +1,115 @@
+package unaryoperator
+
+import (
+	"fmt"
+
+	ufunction "github.com/dairyo/j2g/java/util/function"
+	"github.com/dairyo/j2g/java/util/function/function"
+)
+
+/**
+This is a port of java.util.function.UnaryOperator.
+
+* https://docs.oracle.com/en/java/javase/21/docs/api/java.base/java/util/function/UnaryOperator.html
+* https://github.com/openjdk/jdk/blob/jdk-21%2B35/src/java.base/share/classes/java/util/function/UnaryOperator.java
+*/
+
+// UnaryOperator is a type to represents an operation on a single
+// operand that produces a result of the same type as its operand and
+// error.
+type UnaryOperator[T any] func(T) (T, error)
+
+// WrapNoErr adjusts a function that accepts one argument and produce
+// one result of the same type to UnaryOperator.
+// If f is nil, this function returns nil.
+func WrapNoErr[T any](f func(T) T) UnaryOperator[T] {
+	if f == nil {
+		return nil
+	}
+	return func(in T) (T, error) { return f(in), nil }
+}
+
+// Identity generate a UnaryOperator which always returns its input
+// argument.
+func Identity[T any]() UnaryOperator[T] {
+	return func(in T) (T, error) { return in, nil }
+}
+
+// FromFunction converts [function.Function] to UnaryOperator.
+// If f is nil, this function returns nil.
+func FromFunction[T any](f function.Function[T, T]) UnaryOperator[T] {
+	if f == nil {
+		return nil
+	}
+	return UnaryOperator[T](f)
+}
+
+// ToFunction converts UnaryOperator to [function.Function].
+// If o is nil, this function returns nil.
+func ToFunction[T any](o UnaryOperator[T]) function.Function[T, T] {
+	if o == nil {
+		return nil
+	}
+	return function.Function[T, T](o)
+}
+
+// Recover returns a UnaryOperator which calls o and converts a panic
+// in o to a [*ufunction.PanicError] returned as error.
+// If o is nil, this function returns nil.
+func Recover[T any](o UnaryOperator[T]) UnaryOperator[T] {
+	if o == nil {
+		return nil
+	}
+	return func(in T) (ret T, err error) {
+		defer ufunction.RecoverAsError(&err)
+		return o(in)
+	}
+}
+
+// ChainError is an error returned by a UnaryOperator composed by
+// [Chain] when one of the composed UnaryOperators returns error.
+type ChainError struct {
+	// Index is the 0-based index of the failing UnaryOperator in the
+	// arguments of [Chain].
+	Index int
+	// Err is the error returned by the failing UnaryOperator.
+	Err error
+}
+
+func (e *ChainError) Error() string {
+	return fmt.Sprintf("operator at index %d returns error: %v", e.Index, e.Err)
+}
+
+func (e *ChainError) Unwrap() error {
+	return e.Err
+}
+
+// Chain returns a UnaryOperator composed by arguments. The composed
+// UnaryOperator applies ops in the order of arguments and returned
+// value from each UnaryOperator becomes input to the next one. If
+// one of ops returns error, rest of ops are not evaluated and the
+// composed UnaryOperator returns the zero value and a [*ChainError].
+// If ops is empty, Chain returns [Identity].
+// Chain returns nil if one of ops is nil.
+func Chain[T any](ops ...UnaryOperator[T]) UnaryOperator[T] {
+	for _, o := range ops {
+		if o == nil {
+			return nil
+		}
+	}
+	if len(ops) == 0 {
+		return Identity[T]()
+	}
+	return func(in T) (T, error) {
+		v := in
+		for i, o := range ops {
+			ret, err := o(v)
+			if err != nil {
+				var zero T
+				return zero, &ChainError{Index: i, Err: err}
+			}
+			v = ret
+		}
+		return v, nil
+	}
+}
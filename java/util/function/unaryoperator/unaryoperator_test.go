@@ -0,0 +1,106 @@
+package unaryoperator
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	ufunction "github.com/dairyo/j2g/java/util/function"
+	"github.com/dairyo/j2g/java/util/function/function"
+)
+
+func checkOperator[T comparable](t *testing.T, o UnaryOperator[T], in, want T) {
+	t.Helper()
+	if o == nil {
+		t.Fatal("must not be nil.")
+	}
+	got, err := o(in)
+	if err != nil {
+		t.Fatalf("must not return error but %q.", err)
+	}
+	if got != want {
+		t.Errorf("want=%v, got=%v", want, got)
+	}
+}
+
+var (
+	upper = WrapNoErr(strings.ToUpper)
+	trim  = WrapNoErr(strings.TrimSpace)
+)
+
+func TestWrapNoErr(t *testing.T) {
+	if WrapNoErr[int](nil) != nil {
+		t.Error("must be nil.")
+	}
+	checkOperator(t, upper, "foo", "FOO")
+}
+
+func TestIdentity(t *testing.T) {
+	checkOperator(t, Identity[int](), 1, 1)
+}
+
+func TestConversion(t *testing.T) {
+	if FromFunction[int](nil) != nil {
+		t.Error("must be nil.")
+	}
+	if ToFunction[int](nil) != nil {
+		t.Error("must be nil.")
+	}
+	f := function.WrapNoErr(strings.ToUpper)
+	checkOperator(t, FromFunction(f), "foo", "FOO")
+	got, err := function.Compose(ToFunction(trim), f)(" foo ")
+	if err != nil || got != "FOO" {
+		t.Errorf("want=%q, got=%q, %v", "FOO", got, err)
+	}
+}
+
+func TestChain(t *testing.T) {
+	checkOperator(t, Chain[string](), "foo", "foo")
+	checkOperator(t, Chain(trim), " foo ", "foo")
+	checkOperator(t, Chain(trim, upper), " foo ", "FOO")
+
+	want := errors.New("foo")
+	called := false
+	fail := UnaryOperator[string](func(string) (string, error) { return "", want })
+	last := UnaryOperator[string](func(s string) (string, error) {
+		called = true
+		return s, nil
+	})
+	got, err := Chain(trim, upper, fail, last)(" foo ")
+	var ce *ChainError
+	if !errors.As(err, &ce) {
+		t.Fatalf("must be ChainError but %q.", err)
+	}
+	if ce.Index != 2 {
+		t.Errorf("want=%d, got=%d", 2, ce.Index)
+	}
+	if !errors.Is(err, want) {
+		t.Errorf("error must contain %q but %q.", want, err)
+	}
+	if got, want := err.Error(), "operator at index 2 returns error: foo"; got != want {
+		t.Errorf("want=%q, got=%q", want, got)
+	}
+	if got != "" {
+		t.Errorf("must return zero value but %q.", got)
+	}
+	if called {
+		t.Error("rest of operators must not be called.")
+	}
+
+	if Chain(trim, nil) != nil {
+		t.Error("must be nil.")
+	}
+}
+
+func TestRecover(t *testing.T) {
+	o := Recover(UnaryOperator[int](func(i int) (int, error) { return 10 / i, nil }))
+	checkOperator(t, o, 2, 5)
+	_, err := o(0)
+	var pe *ufunction.PanicError
+	if !errors.As(err, &pe) {
+		t.Fatalf("must be PanicError but %q.", err)
+	}
+	if Recover[int](nil) != nil {
+		t.Error("must be nil.")
+	}
+}
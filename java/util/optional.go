@@ -16,20 +16,76 @@ import (
 	"github.com/dairyo/j2g/java/util/function/supplier"
 )
 
-type optional[T any] interface {
-	IfPresent(consumer.Consumer[T]) error
-	IfPresentOrElse(consumer.Consumer[T], runnable.Runnable) error
-	Filter(predicate.Predicate[T]) *Optional[T]
-	Or(supplier.Supplier[*Optional[T]]) *Optional[T]
-	Get() (T, error)
-	IsPresent() bool
-	IsEmpty() bool
-	Error() error
-}
-
 // Optional is a container of a value.
+//
+// Optional is represented as a value type holding the value, a flag
+// whether the value is present and the reason of emptiness, so an
+// Optional does not need additional allocations for its state. The
+// zero value of Optional is an empty Optional whose [Optional.Error]
+// returns [ErrEmpty].
+//
+// Methods with a pointer receiver, except [Optional.Equal] and the
+// methods which decode into the Optional such as
+// [Optional.UnmarshalJSON] and [Optional.Scan], treat a nil *Optional
+// as the zero value. Methods with a value receiver, such as
+// [Optional.MarshalJSON], [Optional.Value] and [Optional.LogValue],
+// panic on a nil *Optional as any value method does, and the fmt
+// package formats a nil *Optional as "<nil>".
+//
+// Each operation on an Optional returns a new Optional which knows
+// its step in the chain of operations. If an operation makes the
+// Optional empty, [Optional.Error] returns an [*EmptyReason].
+//
+// An Optional returned by [Lazy] holds no state until it is read
+// first. See [Lazy] for details.
 type Optional[T any] struct {
-	o optional[T]
+	val     T
+	err     error
+	present bool
+	step    int
+	recover bool
+	lazy    *lazy[T]
+}
+
+// WithRecover returns an Optional which is the same as this Optional
+// except that panics in callbacks are recovered. Optionals returned
+// by operations on the returned Optional also recover panics.
+//
+// In this mode, a panic in a callback of [Map], [FlatMap],
+// [ZipWith], [Optional.Filter] and [Optional.Or] is converted to a
+// *PanicError of package github.com/dairyo/j2g/java/util/function and
+// the returned Optional becomes empty as if the callback returns the
+// error. Methods returning error, such as [Optional.IfPresent],
+// return the *PanicError.
+func (o *Optional[T]) WithRecover() *Optional[T] {
+	if o == nil {
+		o = &Optional[T]{}
+	}
+	r := *o
+	// recover is set before deferInto so that a panic in resolving a
+	// lazy o is recovered as well.
+	r.recover = true
+	if o.lazy != nil {
+		deferInto(&r, o, func(r, v *Optional[T]) {
+			*r = *v
+			r.recover = true
+		})
+		r.step = o.step
+	}
+	return &r
+}
+
+// fail makes o empty because op at step of o fails with cause. prev
+// is the reason of the input of op if the input is already empty.
+func (o *Optional[T]) fail(op Op, cause, prev error) {
+	root := cause
+	if prev != nil {
+		root = rootOf(prev)
+	}
+	var zero T
+	o.val = zero
+	o.present = false
+	o.err = &EmptyReason{Op: op, Step: o.step, Cause: cause, Root: root}
 }
 
 // IfPresent executes [consumer.Consumer] c if [Optional.IsPresent]
@@ -39,7 +95,17 @@ type Optional[T any] struct {
 //     returns true and c is nil.
 //   - error returned by c is returned if c returns error.
 func (o *Optional[T]) IfPresent(c consumer.Consumer[T]) error {
-	return o.o.IfPresent(c)
+	o = o.load()
+	if !o.present {
+		return ErrNoValue
+	}
+	if c == nil {
+		return ErrNilConsumer
+	}
+	if o.recover {
+		c = consumer.Recover(c)
+	}
+	return c(o.val)
 }
 
 // IfPresentOrElse executes [consumer.Consumer] c if
@@ -55,56 +121,313 @@ func (o *Optional[T]) IfPresent(c consumer.Consumer[T]) error {
 //   - error returned by c is returned if [Optional.IsPresent] is
 //     true and c returns error.
 func (o *Optional[T]) IfPresentOrElse(c consumer.Consumer[T], r runnable.Runnable) error {
-	return o.o.IfPresentOrElse(c, r)
+	o = o.load()
+	if o.present {
+		return o.IfPresent(c)
+	}
+	if r == nil {
+		return ErrInvalidUsed
+	}
+	if o.recover {
+		r = runnable.Recover(r)
+	}
+	return r()
 }
 
-// Filter returns this Optional instance if the value of this Optional
-// matches [predicate.Predicate] p. If the value of thie Optional does
-// not match p or the value is empty, return an Optional instnce which
-// does not have value. If empty Optional is returned, you can get the
-// reason with [Optional.Error]. In this case [Optional.Error] may
-// return following errors:
+// Filter returns an Optional holding the value of this Optional if
+// the value matches [predicate.Predicate] p. If the value of thie
+// Optional does not match p or the value is empty, return an
+// Optional instnce which does not have value. If empty Optional is
+// returned, you can get the reason with [Optional.Error]. In this
+// case [Optional.Error] returns an [*EmptyReason] whose Op is
+// [OpFilter] and whose Cause is one of following errors:
 //   - [ErrNilPredicate] is returned if [predicate.Predicate] p is nil.
 //   - [ErrPredicateFailed] is returned if [predicate.Predicate] p returns false.
 //   - [ErrPredicateErr] is returned if [predicate.Predicate] p
 //     returns error. In this case, the error returned by P is joined
 //     with [errors.Join].
-//   - If Optional instance calling Filter is already empty,
-//     [Optional.Error] returns the original error.
+//
+// If Optional instance calling Filter is already empty,
+// [Optional.Error] returns the original error.
 func (o *Optional[T]) Filter(p predicate.Predicate[T]) *Optional[T] {
-	return o.o.Filter(p)
+	r := &Optional[T]{}
+	filterInto(r, o, p)
+	return r
+}
+
+// filterInto sets the result of [Optional.Filter] to r. The
+// allocation of r is left to Filter so that Filter is inlined and r
+// may be allocated on the stack.
+func filterInto[T any](r, o *Optional[T], p predicate.Predicate[T]) {
+	if o == nil {
+		o = &Optional[T]{}
+	}
+	if o.lazy != nil {
+		deferInto(r, o, func(r, o *Optional[T]) { filterInto(r, o, p) })
+		return
+	}
+	*r = *o
+	r.step++
+	if !o.present {
+		return
+	}
+	if p == nil {
+		r.fail(OpFilter, ErrNilPredicate, nil)
+		return
+	}
+	// p is not reassigned so that the closure for a lazy Optional
+	// above does not move p to the heap.
+	call := p
+	if o.recover {
+		call = predicate.Recover(p)
+	}
+	ok, err := call(o.val)
+	if err != nil {
+		r.fail(OpFilter, errors.Join(ErrPredicateErr, err), nil)
+		return
+	}
+	if !ok {
+		r.fail(OpFilter, ErrPredicateFailed, nil)
+	}
 }
 
-// Or returns this Optional instance if the value of this Optional is
-// present. Otherwise returns an Optional produced by
-// [supplier.Supplier] s. Or returns empty Optional in following
-// cases:
-//   - [supplier.Supplier] s is Nil. In this case, [Optional.Error] returns [ErrNilSupplier]
-//   - [supplier.Supplier] s returns error. In this case [Optional.Error] returns an error which contains [ErrSupplierErr], error returned by [supplier.Supplier] and error which is contained by base empty Optional.
+// Or returns an Optional holding the value of this Optional if the
+// value of this Optional is present. Otherwise returns an Optional
+// produced by [supplier.Supplier] s. Or returns empty Optional whose
+// [Optional.Error] returns an [*EmptyReason] whose Op is [OpOr] in
+// following cases:
+//   - [supplier.Supplier] s is Nil. In this case, Cause is [ErrNilSupplier]
+//   - [supplier.Supplier] s returns error. In this case Cause is an error which contains [ErrSupplierErr] and error returned by [supplier.Supplier].
+//   - [supplier.Supplier] s returns nil. In this case Cause is [ErrEmpty].
+//
+// If this Optional is empty, Root of the [*EmptyReason] is the root
+// error of this Optional.
 func (o *Optional[T]) Or(s supplier.Supplier[*Optional[T]]) *Optional[T] {
-	return o.o.Or(s)
+	r := &Optional[T]{}
+	orInto(r, o, s)
+	return r
+}
+
+// orInto sets the result of [Optional.Or] to r. The allocation of r
+// is left to Or so that Or is inlined and r may be allocated on the
+// stack.
+func orInto[T any](r, o *Optional[T], s supplier.Supplier[*Optional[T]]) {
+	if o == nil {
+		o = &Optional[T]{}
+	}
+	if o.lazy != nil {
+		deferInto(r, o, func(r, o *Optional[T]) { orInto(r, o, s) })
+		return
+	}
+	*r = *o
+	r.step++
+	if o.present {
+		if s == nil {
+			r.fail(OpOr, ErrNilSupplier, nil)
+		}
+		return
+	}
+	prev := o.Error()
+	if s == nil {
+		r.fail(OpOr, ErrNilSupplier, prev)
+		return
+	}
+	call := s
+	if o.recover {
+		call = supplier.Recover(s)
+	}
+	ret, err := call()
+	if err != nil {
+		r.fail(OpOr, errors.Join(ErrSupplierErr, err), prev)
+		return
+	}
+	if ret == nil {
+		r.fail(OpOr, ErrEmpty, prev)
+		return
+	}
+	*r = *ret.load()
+	r.step = o.step + 1
+	r.recover = o.recover
 }
 
 // Get returns a value in this Optional instance if
 // [Optional.IsPresent] is true. Otherwise Get returns [ErrNoValue].
 func (o *Optional[T]) Get() (T, error) {
-	return o.o.Get()
+	o = o.load()
+	if !o.present {
+		var zero T
+		return zero, ErrNoValue
+	}
+	return o.val, nil
 }
 
 // IsPresent returns true if this Optional instance has a
 // value. Otherwise return false.
 func (o *Optional[T]) IsPresent() bool {
-	return o.o.IsPresent()
+	o = o.load()
+	return o.present
 }
 
 // IsEmpty returns true if this Optional instance does not have a
 // value. Otherwise return true.
 func (o *Optional[T]) IsEmpty() bool {
-	return o.o.IsEmpty()
+	o = o.load()
+	return !o.present
 }
 
 func (o *Optional[T]) Error() error {
-	return o.o.Error()
+	o = o.load()
+	if o.present {
+		return nil
+	}
+	if o.err == nil {
+		return ErrEmpty
+	}
+	return o.err
+}
+
+// OrElse returns the value of this Optional instance if
+// [Optional.IsPresent] is true. Otherwise returns other.
+func (o *Optional[T]) OrElse(other T) T {
+	o = o.load()
+	if o.present {
+		return o.val
+	}
+	return other
+}
+
+// OrElseGet returns the value of this Optional instance if
+// [Optional.IsPresent] is true. Otherwise returns the value produced
+// by [supplier.Supplier] s. OrElseGet may return following errors:
+//   - [ErrNilSupplier] is returned if s is nil. If this Optional is
+//     empty, the error is joined with [Optional.Error].
+//   - If s returns error, an error which contains [ErrSupplierErr],
+//     [Optional.Error] and the error returned by s is returned.
+func (o *Optional[T]) OrElseGet(s supplier.Supplier[T]) (T, error) {
+	o = o.load()
+	var zero T
+	if o.present {
+		if s == nil {
+			return zero, ErrNilSupplier
+		}
+		return o.val, nil
+	}
+	if s == nil {
+		return zero, errors.Join(ErrNilSupplier, o.Error())
+	}
+	if o.recover {
+		s = supplier.Recover(s)
+	}
+	ret, err := s()
+	if err != nil {
+		return zero, errors.Join(ErrSupplierErr, o.Error(), err)
+	}
+	return ret, nil
+}
+
+// OrElseErr returns the value of this Optional instance if
+// [Optional.IsPresent] is true. Otherwise returns the error produced
+// by [supplier.Supplier] s. This is a replacement of java Optional's
+// orElseThrow. OrElseErr may return following errors:
+//   - [ErrNilSupplier] is returned if s is nil. If this Optional is
+//     empty, the error is joined with [Optional.Error].
+//   - If s returns error, an error which contains [ErrSupplierErr],
+//     [Optional.Error] and the error returned by s is returned.
+//   - [ErrNoValue] is returned if this Optional is empty and s
+//     produces nil.
+func (o *Optional[T]) OrElseErr(s supplier.Supplier[error]) (T, error) {
+	o = o.load()
+	var zero T
+	if o.present {
+		if s == nil {
+			return zero, ErrNilSupplier
+		}
+		return o.val, nil
+	}
+	if s == nil {
+		return zero, errors.Join(ErrNilSupplier, o.Error())
+	}
+	if o.recover {
+		s = supplier.Recover(s)
+	}
+	ret, err := s()
+	if err != nil {
+		return zero, errors.Join(ErrSupplierErr, o.Error(), err)
+	}
+	if ret == nil {
+		return zero, ErrNoValue
+	}
+	return zero, ret
+}
+
+// Equals returns true if obj is an *Optional[T] and both Optionals
+// are empty or both Optionals have equal values. Values are compared
+// with [reflect.DeepEqual]. The reasons of empty Optionals are not
+// compared.
+func (o *Optional[T]) Equals(obj any) bool {
+	other, ok := obj.(*Optional[T])
+	if !ok || other == nil {
+		return false
+	}
+	o, other = o.load(), other.load()
+	if o.present != other.present {
+		return false
+	}
+	if !o.present {
+		return true
+	}
+	return reflect.DeepEqual(o.val, other.val)
+}
+
+// Equal reports whether this Optional and other are equal. It is
+// picked up by [github.com/google/go-cmp/cmp.Equal] and
+// [github.com/google/go-cmp/cmp.Diff]. Equal returns true in
+// following cases:
+//   - both are nil.
+//   - both have values and the values are equal. If T has a method
+//     Equal(T) bool, it is used. Otherwise the values are compared
+//     with [reflect.DeepEqual].
+//   - both are empty and their [Optional.Error] describe the same
+//     failure. The errors match if they match each other with
+//     [errors.Is]. Otherwise [*EmptyReason], errors joined by
+//     [errors.Join] and wrapping errors are compared part by part,
+//     so that reasons created for each failure, such as the reasons
+//     of [Zip2], match if their parts match with errors.Is.
+//
+// Unlike [Optional.Equals], Equal compares the reasons of empty
+// Optionals.
+//
+// Equal is defined on the pointer receiver, so go-cmp uses it for
+// *Optional[T] but not for Optional[T]. go-cmp panics for an
+// Optional[T] value, such as a struct field, because Optional has
+// unexported fields. Use
+// [github.com/dairyo/j2g/java/util/optionalcmp.Compare] to compare
+// such values.
+func (o *Optional[T]) Equal(other *Optional[T]) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	o, other = o.load(), other.load()
+	if o.present != other.present {
+		return false
+	}
+	if !o.present {
+		return sameError(o.Error(), other.Error())
+	}
+	if eq, ok := any(o.val).(interface{ Equal(T) bool }); ok {
+		return eq.Equal(other.val)
+	}
+	return reflect.DeepEqual(o.val, other.val)
+}
+
+// String returns "Optional[v]" if this Optional has value v.
+// Otherwise returns "Optional.empty".
+func (o *Optional[T]) String() string {
+	o = o.load()
+	if !o.present {
+		return "Optional.empty"
+	}
+	return fmt.Sprintf("Optional[%v]", o.val)
 }
 
 func isNilable(k reflect.Kind) bool {
@@ -121,67 +444,203 @@ func isNilable(k reflect.Kind) bool {
 	}
 }
 
+// isNil reports whether v should be treated as Java's null. A nil
+// interface, and a nil chan, func, map, pointer or slice are
+// treated as null, even if they are held in an interface. Values of
+// other kinds are never null.
+//
+// The kind of T is checked first so that values of non-nilable types
+// do not go through reflection of the value.
+func isNil[T any](v T) bool {
+	k := reflect.TypeOf((*T)(nil)).Elem().Kind()
+	if k != reflect.Interface && !isNilable(k) {
+		return false
+	}
+	i := any(v)
+	if i == nil {
+		return true
+	}
+	rv := reflect.ValueOf(i)
+	if isNilable(rv.Kind()) {
+		return rv.IsNil()
+	}
+	return false
+}
+
+func newErr[T any](err error) *Optional[T] {
+	return &Optional[T]{err: err}
+}
+
+// Of returns an [Optional] instance holding value v. This is a port
+// of java Optional's of. Unlike java, Of does not panic if v is nil
+// but returns empty [Optional] whose [Optional.Error] returns
+// [ErrNilValue].
+//
+// The behaviors of Of, [OfNullable] and [Empty] compared with java
+// are following:
+//
+//	| Go                         | Go result          | Java                      | Java result          |
+//	|----------------------------|--------------------|---------------------------|----------------------|
+//	| Of(v)                      | has v              | Optional.of(v)            | has v                |
+//	| Of[*T](nil)                | empty, ErrNilValue | Optional.of(null)         | NullPointerException |
+//	| Of[any](nil)               | empty, ErrNilValue | Optional.of(null)         | NullPointerException |
+//	| Of[any]((*T)(nil))         | empty, ErrNilValue | (no equivalent)           | -                    |
+//	| Of(0)                      | has 0              | Optional.of(0)            | has 0                |
+//	| OfNullable(v)              | has v              | Optional.ofNullable(v)    | has v                |
+//	| OfNullable[*T](nil)        | empty, ErrEmpty    | Optional.ofNullable(null) | Optional.empty       |
+//	| OfNullable[any](nil)       | empty, ErrEmpty    | Optional.ofNullable(null) | Optional.empty       |
+//	| OfNullable[any]((*T)(nil)) | empty, ErrEmpty    | (no equivalent)           | -                    |
+//	| Empty[T]()                 | empty, ErrEmpty    | Optional.empty()          | Optional.empty       |
+//	| OfPresent[*T](nil)         | has nil            | (no equivalent)           | -                    |
+//
+// Nil means a nil interface or a nil chan, func, map, pointer or
+// slice. A nil value held in a non-nil interface is also treated as
+// nil because java does not have such a value.
+func Of[T any](v T) *Optional[T] {
+	if isNil(v) {
+		return newErr[T](ErrNilValue)
+	}
+	return &Optional[T]{val: v, present: true}
+}
+
+// OfNullable returns an [Optional] instance holding value v. If v is
+// nil, OfNullable returns empty [Optional] whose [Optional.Error]
+// returns [ErrEmpty]. This is a port of java Optional's
+// ofNullable. See [Of] for details of nil.
+func OfNullable[T any](v T) *Optional[T] {
+	// err is left nil because Error of an empty Optional without
+	// reason returns ErrEmpty. This keeps OfNullable small enough to
+	// be inlined.
+	return &Optional[T]{val: v, present: !isNil(v)}
+}
+
+// OfPresent returns an [Optional] instance holding value v even if v
+// is nil. Java Optional can not hold null, so OfPresent has no
+// equivalent in java. It is for converting a value which may be nil,
+// such as a value held by a Result, to an Optional without losing
+// the value. See [Of] for details of nil.
+func OfPresent[T any](v T) *Optional[T] {
+	return &Optional[T]{val: v, present: true}
+}
+
+// Empty returns an empty [Optional] instance whose [Optional.Error]
+// returns [ErrEmpty]. This is a port of java Optional's empty.
+func Empty[T any]() *Optional[T] {
+	return newErr[T](ErrEmpty)
+}
+
+// EmptyWithError returns an empty [Optional] instance whose
+// [Optional.Error] returns err. If err is nil, EmptyWithError is the
+// same as [Empty].
+func EmptyWithError[T any](err error) *Optional[T] {
+	if err == nil {
+		return Empty[T]()
+	}
+	return newErr[T](err)
+}
+
 // NewOptional returns an [Optional] instance holding value v.
 // If v is nil, NewOptional returns empty [Optional].
+//
+// Deprecated: Use [OfNullable] instead. NewOptional is the same as
+// [OfNullable].
 func NewOptional[T any](v T) *Optional[T] {
-	rv := reflect.ValueOf(v)
-	vt := rv.Type()
-	if isNilable(vt.Kind()) {
-		if rv.IsNil() {
-			return newErr[T](ErrEmpty)
-		}
-	}
-	vo := &valueOptional[T]{val: v}
-	ret := &Optional[T]{}
-	vo.parent = ret
-	ret.o = vo
-	return ret
+	return OfNullable(v)
 }
 
 // Map returns new [Optional] instance holding the result of applying
 // the given mapping function f.
-// If v is empty or nil NewOptional returns empty [Optional]
-// instance. NewOptional also returns empty [Optional] instance if f
-// is nil.
+// If v is empty or nil Map returns empty [Optional] instance. Map
+// also returns empty [Optional] instance if f is nil, f returns error
+// or f returns nil. If v is empty, [Optional.Error] of the returned
+// Optional is the same as v. Otherwise [Optional.Error] returns an
+// [*EmptyReason] whose Op is [OpMap].
 func Map[T, U any](v *Optional[T], f function.Function[T, U]) *Optional[U] {
-	ret, err := innerMap(v, f)
-	if err != nil {
-		return newErr[U](err)
-	}
-	return NewOptional(ret)
+	o := &Optional[U]{}
+	mapInto(o, v, f)
+	return o
 }
 
 // FlatMap returns new [Optional] instance which is a returned value
 // of the given mapping function f.
-// If v is empty or nil NewOptional returns empty [Optional]
-// instance. NewOptional also returns empty [Optional] instance if f
-// is nil.
+// If v is empty or nil FlatMap returns empty [Optional] instance.
+// FlatMap also returns empty [Optional] instance if f is nil, f
+// returns error or f returns nil. If v is empty, [Optional.Error] of
+// the returned Optional is the same as v. Otherwise [Optional.Error]
+// returns an [*EmptyReason] whose Op is [OpFlatMap] or the error of
+// the Optional returned by f.
 func FlatMap[T, U any](v *Optional[T], f function.Function[T, *Optional[U]]) *Optional[U] {
-	ret, err := innerMap(v, f)
-	if err != nil {
-		return newErr[U](err)
+	o := &Optional[U]{}
+	flatMapInto(o, v, f)
+	return o
+}
+
+// mapInto sets the result of [Map] to o. The allocation of o is left
+// to Map so that Map is inlined and o may be allocated on the stack.
+func mapInto[T, U any](o *Optional[U], v *Optional[T], f function.Function[T, U]) {
+	if v.isLazy() {
+		deferInto(o, v, func(o *Optional[U], v *Optional[T]) { mapInto(o, v, f) })
+		return
+	}
+	ret, ok := innerMap(o, OpMap, v, f)
+	if !ok {
+		return
+	}
+	if isNil(ret) {
+		o.fail(OpMap, ErrEmpty, nil)
+		return
+	}
+	o.val = ret
+	o.present = true
+}
+
+// flatMapInto sets the result of [FlatMap] to o in the same way as
+// [mapInto].
+func flatMapInto[T, U any](o *Optional[U], v *Optional[T], f function.Function[T, *Optional[U]]) {
+	if v.isLazy() {
+		deferInto(o, v, func(o *Optional[U], v *Optional[T]) { flatMapInto(o, v, f) })
+		return
 	}
-	return ret
+	ret, ok := innerMap(o, OpFlatMap, v, f)
+	if !ok {
+		return
+	}
+	if ret == nil {
+		o.fail(OpFlatMap, ErrEmpty, nil)
+		return
+	}
+	step, rec := o.step, o.recover
+	*o = *ret.load()
+	o.step = step
+	o.recover = rec
 }
 
-func innerMap[T, U any](v *Optional[T], f function.Function[T, U]) (U, error) {
+// innerMap applies f to the value of v. If it fails, innerMap makes o
+// empty and returns false.
+func innerMap[T, U, V any](o *Optional[V], op Op, v *Optional[T], f function.Function[T, U]) (U, bool) {
+	var zero U
 	if v == nil {
-		return *new(U), ErrMapNilOptinal
+		o.step = 1
+		o.fail(op, ErrMapNilOptinal, nil)
+		return zero, false
 	}
+	o.step = v.step + 1
+	o.recover = v.recover
 	if f == nil {
-		return *new(U), ErrMapNilFunction
+		o.fail(op, ErrMapNilFunction, nil)
+		return zero, false
 	}
-
-	switch val := v.o.(type) {
-	case *errorOptional[T]:
-		return *new(U), fmt.Errorf("invalid optional is passed: %w", val.err)
-	case *valueOptional[T]:
-		ret, err := f(val.val)
-		if err != nil {
-			return *new(U), fmt.Errorf("function returns error: %w", err)
-		}
-		return ret, nil
-	default:
-		return *new(U), errors.New("unknown Optional type")
+	if !v.present {
+		o.err = v.Error()
+		return zero, false
+	}
+	if v.recover {
+		f = function.Recover(f)
+	}
+	ret, err := f(v.val)
+	if err != nil {
+		o.fail(op, fmt.Errorf("function returns error: %w", err), nil)
+		return zero, false
 	}
+	return ret, true
 }
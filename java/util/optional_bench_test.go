@@ -0,0 +1,76 @@
+package util
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/dairyo/j2g/java/util/function/function"
+)
+
+var (
+	sinkInt    int
+	sinkString string
+	sinkBool   bool
+)
+
+func BenchmarkNewOptional(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		o := NewOptional(i)
+		sinkBool = o.IsPresent()
+	}
+}
+
+func BenchmarkNewOptionalPointer(b *testing.B) {
+	b.ReportAllocs()
+	v := 1
+	for i := 0; i < b.N; i++ {
+		o := NewOptional(&v)
+		sinkBool = o.IsPresent()
+	}
+}
+
+func BenchmarkGet(b *testing.B) {
+	b.ReportAllocs()
+	o := NewOptional(1)
+	for i := 0; i < b.N; i++ {
+		sinkInt, _ = o.Get()
+	}
+}
+
+func BenchmarkMap(b *testing.B) {
+	b.ReportAllocs()
+	f := function.WrapNoErr(func(i int) int { return i + 1 })
+	for i := 0; i < b.N; i++ {
+		o := Map(NewOptional(i), f)
+		sinkInt, _ = o.Get()
+	}
+}
+
+func BenchmarkMapIntToString(b *testing.B) {
+	b.ReportAllocs()
+	f := function.WrapNoErr(strconv.Itoa)
+	for i := 0; i < b.N; i++ {
+		o := Map(NewOptional(1), f)
+		sinkString, _ = o.Get()
+	}
+}
+
+func BenchmarkFilter(b *testing.B) {
+	b.ReportAllocs()
+	p := func(i int) (bool, error) { return i >= 0, nil }
+	for i := 0; i < b.N; i++ {
+		o := NewOptional(i).Filter(p)
+		sinkInt, _ = o.Get()
+	}
+}
+
+func BenchmarkChain(b *testing.B) {
+	b.ReportAllocs()
+	f := function.WrapNoErr(func(i int) int { return i * 2 })
+	p := func(i int) (bool, error) { return i >= 0, nil }
+	for i := 0; i < b.N; i++ {
+		o := Map(Map(NewOptional(i), f).Filter(p), f)
+		sinkInt = o.OrElse(0)
+	}
+}
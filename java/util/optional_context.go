@@ -0,0 +1,103 @@
+package util
+
+import (
+	"context"
+
+	"github.com/dairyo/j2g/java/util/function/function"
+	"github.com/dairyo/j2g/java/util/function/predicate"
+	"github.com/dairyo/j2g/java/util/function/supplier"
+)
+
+// MapCtx is the same as [Map] but f accepts ctx. If ctx is already
+// done when f would be called, f is not called and MapCtx returns an
+// empty Optional whose [Optional.Error] returns an [*EmptyReason]
+// whose Cause is ctx.Err().
+func MapCtx[T, U any](ctx context.Context, v *Optional[T], f function.FunctionCtx[T, U]) *Optional[U] {
+	o := &Optional[U]{}
+	if v.isLazy() {
+		deferInto(o, v, func(o *Optional[U], v *Optional[T]) { *o = *MapCtx(ctx, v, f) })
+		return o
+	}
+	if !failIfDone(ctx, o, OpMap, v, f == nil) {
+		mapInto(o, v, function.Bind(ctx, f))
+	}
+	return o
+}
+
+// FlatMapCtx is the same as [FlatMap] but f accepts ctx. If ctx is
+// already done when f would be called, f is not called and
+// FlatMapCtx returns an empty Optional whose [Optional.Error] returns
+// an [*EmptyReason] whose Cause is ctx.Err().
+func FlatMapCtx[T, U any](ctx context.Context, v *Optional[T], f function.FunctionCtx[T, *Optional[U]]) *Optional[U] {
+	o := &Optional[U]{}
+	if v.isLazy() {
+		deferInto(o, v, func(o *Optional[U], v *Optional[T]) { *o = *FlatMapCtx(ctx, v, f) })
+		return o
+	}
+	if !failIfDone(ctx, o, OpFlatMap, v, f == nil) {
+		flatMapInto(o, v, function.Bind(ctx, f))
+	}
+	return o
+}
+
+// FilterCtx is the same as [Optional.Filter] but p accepts ctx. If
+// ctx is already done when p would be called, p is not called and
+// FilterCtx returns an empty Optional whose [Optional.Error] returns
+// an [*EmptyReason] whose Cause is ctx.Err().
+func (o *Optional[T]) FilterCtx(ctx context.Context, p predicate.PredicateCtx[T]) *Optional[T] {
+	if o == nil {
+		o = &Optional[T]{}
+	}
+	r := &Optional[T]{}
+	if o.lazy != nil {
+		deferInto(r, o, func(r, o *Optional[T]) { *r = *o.FilterCtx(ctx, p) })
+		return r
+	}
+	if !failIfDone(ctx, r, OpFilter, o, p == nil) {
+		filterInto(r, o, predicate.Bind(ctx, p))
+	}
+	return r
+}
+
+// OrCtx is the same as [Optional.Or] but s accepts ctx. If ctx is
+// already done when s would be called, s is not called and OrCtx
+// returns an empty Optional whose [Optional.Error] returns an
+// [*EmptyReason] whose Cause is ctx.Err().
+func (o *Optional[T]) OrCtx(ctx context.Context, s supplier.SupplierCtx[*Optional[T]]) *Optional[T] {
+	if o == nil {
+		o = &Optional[T]{}
+	}
+	r := &Optional[T]{}
+	if o.lazy != nil {
+		deferInto(r, o, func(r, o *Optional[T]) { *r = *o.OrCtx(ctx, s) })
+		return r
+	}
+	if !o.present && s != nil {
+		if err := ctx.Err(); err != nil {
+			r.step = o.step + 1
+			r.recover = o.recover
+			r.fail(OpOr, err, o.Error())
+			return r
+		}
+	}
+	orInto(r, o, supplier.Bind(ctx, s))
+	return r
+}
+
+// failIfDone makes r empty and returns true if the callback of op
+// would be called with the value of v but ctx is already done.
+// nilCallback is true if the callback is nil, in which case the
+// callback is never called.
+func failIfDone[T, U any](ctx context.Context, r *Optional[U], op Op, v *Optional[T], nilCallback bool) bool {
+	if v == nil || !v.present || nilCallback {
+		return false
+	}
+	err := ctx.Err()
+	if err == nil {
+		return false
+	}
+	r.step = v.step + 1
+	r.recover = v.recover
+	r.fail(op, err, nil)
+	return true
+}
@@ -0,0 +1,114 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dairyo/j2g/java/util/function/function"
+)
+
+func TestMapCtx(t *testing.T) {
+	type key struct{}
+	ctx := context.WithValue(context.Background(), key{}, 1)
+	add := func(ctx context.Context, i int) (int, error) { return i + ctx.Value(key{}).(int), nil }
+
+	t.Run("not done", func(t *testing.T) {
+		checkGet(t, MapCtx(ctx, NewOptional(1), add), 2)
+		o := FlatMapCtx(ctx, NewOptional(1), func(ctx context.Context, i int) (*Optional[int], error) {
+			return NewOptional(i + ctx.Value(key{}).(int)), nil
+		})
+		checkGet(t, o, 2)
+	})
+
+	t.Run("done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+		called := false
+		f := func(context.Context, int) (int, error) {
+			called = true
+			return 0, nil
+		}
+		o := MapCtx(ctx, Map(NewOptional(1), function.Identity[int]()), f)
+		r := checkReason(t, o.Error(), OpMap, 2)
+		if r.Cause != context.Canceled {
+			t.Errorf("want=%q, got=%q", context.Canceled, r.Cause)
+		}
+		if called {
+			t.Error("should not be called.")
+		}
+
+		o2 := FlatMapCtx(ctx, NewOptional(1), func(context.Context, int) (*Optional[int], error) {
+			called = true
+			return NewOptional(1), nil
+		})
+		checkReason(t, o2.Error(), OpFlatMap, 1)
+		if !errors.Is(o2.Error(), context.Canceled) {
+			t.Errorf("error must contain %q but %q.", context.Canceled, o2.Error())
+		}
+		if called {
+			t.Error("should not be called.")
+		}
+	})
+
+	t.Run("done and empty", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+		if got := MapCtx(ctx, Empty[int](), add).Error(); got != ErrEmpty {
+			t.Errorf("want=%q, got=%q", ErrEmpty, got)
+		}
+	})
+
+	t.Run("nil function", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+		o := MapCtx[int, int](ctx, NewOptional(1), nil)
+		if r := checkReason(t, o.Error(), OpMap, 1); r.Cause != ErrMapNilFunction {
+			t.Errorf("want=%q, got=%q", ErrMapNilFunction, r.Cause)
+		}
+	})
+}
+
+func TestFilterCtx(t *testing.T) {
+	positive := func(_ context.Context, i int) (bool, error) { return i > 0, nil }
+	checkGet(t, NewOptional(1).FilterCtx(context.Background(), positive), 1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 0)
+	defer cancel()
+	<-ctx.Done()
+	o := NewOptional(1).FilterCtx(ctx, positive)
+	if r := checkReason(t, o.Error(), OpFilter, 1); r.Cause != context.DeadlineExceeded {
+		t.Errorf("want=%q, got=%q", context.DeadlineExceeded, r.Cause)
+	}
+
+	var n *Optional[int]
+	o = n.FilterCtx(ctx, positive)
+	if err := o.Error(); err != ErrEmpty {
+		t.Errorf("want=%q, got=%q", ErrEmpty, err)
+	}
+}
+
+func TestOrCtx(t *testing.T) {
+	supply := func(context.Context) (*Optional[int], error) { return NewOptional(2), nil }
+	checkGet(t, Empty[int]().OrCtx(context.Background(), supply), 2)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	checkGet(t, NewOptional(1).OrCtx(ctx, supply), 1)
+
+	o := Empty[int]().OrCtx(ctx, supply)
+	r := checkReason(t, o.Error(), OpOr, 1)
+	if r.Cause != context.Canceled {
+		t.Errorf("want=%q, got=%q", context.Canceled, r.Cause)
+	}
+	if r.Root != ErrEmpty {
+		t.Errorf("want=%q, got=%q", ErrEmpty, r.Root)
+	}
+
+	var n *Optional[int]
+	checkGet(t, n.OrCtx(context.Background(), supply), 2)
+	o = n.OrCtx(ctx, supply)
+	if r := checkReason(t, o.Error(), OpOr, 1); r.Cause != context.Canceled {
+		t.Errorf("want=%q, got=%q", context.Canceled, r.Cause)
+	}
+}
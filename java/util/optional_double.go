@@ -0,0 +1,74 @@
+package util
+
+import (
+	"fmt"
+	"math"
+)
+
+// OptionalDouble is a container of a float64 value. This is a port of
+// java.util.OptionalDouble.
+//
+//   - https://docs.oracle.com/en/java/javase/21/docs/api/java.base/java/util/OptionalDouble.html
+//   - https://github.com/openjdk/jdk/blob/jdk-21%2B35/src/java.base/share/classes/java/util/OptionalDouble.java
+type OptionalDouble struct {
+	primitiveOptional[float64]
+}
+
+// OfDouble returns an [OptionalDouble] instance holding value v.
+func OfDouble(v float64) *OptionalDouble {
+	return &OptionalDouble{primitiveOptional[float64]{val: v, present: true}}
+}
+
+// EmptyDouble returns an empty [OptionalDouble] instance whose
+// [OptionalDouble.Error] returns [ErrEmpty].
+func EmptyDouble() *OptionalDouble {
+	return &OptionalDouble{}
+}
+
+// DoubleFromOptional converts [Optional] o to [OptionalDouble]. If o is
+// empty, the returned instance is also empty and keeps
+// [Optional.Error] of o. If o is nil, an empty instance is returned.
+func DoubleFromOptional(o *Optional[float64]) *OptionalDouble {
+	return &OptionalDouble{fromOptional(o)}
+}
+
+// GetAsDouble returns the value if this instance has a value. Otherwise
+// returns [ErrNoValue].
+func (o *OptionalDouble) GetAsDouble() (float64, error) {
+	return o.get()
+}
+
+// ToOptional converts this instance to [Optional]. The reason of
+// emptiness is kept.
+func (o *OptionalDouble) ToOptional() *Optional[float64] {
+	return o.toOptional()
+}
+
+// Equals returns true if obj is an *OptionalDouble and both are empty
+// or both have equal values. Like java, NaN is equal to NaN and 0.0
+// is not equal to -0.0.
+func (o *OptionalDouble) Equals(obj any) bool {
+	other, ok := obj.(*OptionalDouble)
+	if !ok || other == nil {
+		return false
+	}
+	if o.present != other.present {
+		return false
+	}
+	if !o.present {
+		return true
+	}
+	if math.IsNaN(o.val) && math.IsNaN(other.val) {
+		return true
+	}
+	return math.Float64bits(o.val) == math.Float64bits(other.val)
+}
+
+// String returns "OptionalDouble[v]" if this instance has value v.
+// Otherwise returns "OptionalDouble.empty".
+func (o *OptionalDouble) String() string {
+	if !o.present {
+		return "OptionalDouble.empty"
+	}
+	return fmt.Sprintf("OptionalDouble[%v]", o.val)
+}
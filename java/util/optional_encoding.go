@@ -0,0 +1,188 @@
+package util
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// Marshaling methods are defined on the value receiver so that both
+// Optional[T] and *Optional[T] fields are marshaled. Unmarshaling
+// methods are defined on the pointer receiver.
+//
+// Note that encoding/json sets a nil *Optional[T] field when it
+// decodes null into the field. The reading methods of Optional, such
+// as [Optional.Get] and [Optional.Error], treat a nil *Optional[T] as
+// an empty Optional whose [Optional.Error] returns [ErrEmpty], so such
+// a field can be read as empty. See [Optional] for the methods which
+// do not accept a nil *Optional[T].
+
+var jsonNull = []byte("null")
+
+// MarshalJSON implements [json.Marshaler]. A present Optional is
+// marshaled as its value. An empty Optional is marshaled as null.
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	o = *o.load()
+	if !o.present {
+		return jsonNull, nil
+	}
+	return json.Marshal(o.val)
+}
+
+// UnmarshalJSON implements [json.Unmarshaler]. null is unmarshaled
+// as an empty Optional whose [Optional.Error] returns [ErrEmpty].
+// Other values are unmarshaled as the value of the Optional. If the
+// value can not be unmarshaled to T, UnmarshalJSON returns the error
+// and o is not changed.
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), jsonNull) {
+		*o = Optional[T]{err: ErrEmpty}
+		return nil
+	}
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*o = Optional[T]{val: v, present: true}
+	return nil
+}
+
+// MarshalText implements [encoding.TextMarshaler]. An empty Optional
+// is marshaled as empty text. A present Optional is marshaled with
+// the MarshalText method of the value if T implements
+// [encoding.TextMarshaler]. Otherwise the value is formatted with
+// [fmt.Sprint].
+func (o Optional[T]) MarshalText() ([]byte, error) {
+	o = *o.load()
+	if !o.present {
+		return []byte{}, nil
+	}
+	if m, ok := any(o.val).(encoding.TextMarshaler); ok {
+		return m.MarshalText()
+	}
+	return []byte(fmt.Sprint(o.val)), nil
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler]. Empty text is
+// unmarshaled as an empty Optional whose [Optional.Error] returns
+// [ErrEmpty]. Otherwise the text is unmarshaled with the
+// UnmarshalText method of *T if *T implements
+// [encoding.TextUnmarshaler]. Otherwise T must be a string, bool,
+// integer or floating-point type.
+func (o *Optional[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*o = Optional[T]{err: ErrEmpty}
+		return nil
+	}
+	var v T
+	if u, ok := any(&v).(encoding.TextUnmarshaler); ok {
+		if err := u.UnmarshalText(text); err != nil {
+			return err
+		}
+	} else if err := parseText(reflect.ValueOf(&v).Elem(), string(text)); err != nil {
+		return err
+	}
+	*o = Optional[T]{val: v, present: true}
+	return nil
+}
+
+func parseText(v reflect.Value, s string) error {
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	default:
+		return fmt.Errorf("can not unmarshal text into %s", v.Type())
+	}
+	return nil
+}
+
+// MarshalXML implements [xml.Marshaler]. A present Optional is
+// encoded as the element of its value. Nothing is encoded for an
+// empty Optional, so the element is omitted.
+func (o Optional[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	o = *o.load()
+	if !o.present {
+		return nil
+	}
+	return e.EncodeElement(o.val, start)
+}
+
+// UnmarshalXML implements [xml.Unmarshaler]. The element is decoded
+// as the value of the Optional. If the element is omitted,
+// UnmarshalXML is not called and the Optional keeps its zero value,
+// which is empty.
+func (o *Optional[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var v T
+	if err := d.DecodeElement(&v, &start); err != nil {
+		return err
+	}
+	*o = Optional[T]{val: v, present: true}
+	return nil
+}
+
+// GobEncode implements [gob.GobEncoder]. Whether the value is present
+// and the value are encoded. The reason of an empty Optional is not
+// encoded.
+func (o Optional[T]) GobEncode() ([]byte, error) {
+	o = *o.load()
+	var buf bytes.Buffer
+	enc := gob.NewEncoder(&buf)
+	if err := enc.Encode(o.present); err != nil {
+		return nil, err
+	}
+	if o.present {
+		if err := enc.Encode(o.val); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode implements [gob.GobDecoder]. An empty Optional is decoded
+// as an empty Optional whose [Optional.Error] returns [ErrEmpty].
+func (o *Optional[T]) GobDecode(data []byte) error {
+	dec := gob.NewDecoder(bytes.NewReader(data))
+	var present bool
+	if err := dec.Decode(&present); err != nil {
+		return err
+	}
+	if !present {
+		*o = Optional[T]{err: ErrEmpty}
+		return nil
+	}
+	var v T
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	*o = Optional[T]{val: v, present: true}
+	return nil
+}
@@ -0,0 +1,238 @@
+package util
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"encoding/xml"
+	"net/netip"
+	"testing"
+)
+
+var (
+	_ json.Marshaler           = Optional[int]{}
+	_ json.Unmarshaler         = (*Optional[int])(nil)
+	_ encoding.TextMarshaler   = Optional[int]{}
+	_ encoding.TextUnmarshaler = (*Optional[int])(nil)
+	_ xml.Marshaler            = Optional[int]{}
+	_ xml.Unmarshaler          = (*Optional[int])(nil)
+	_ gob.GobEncoder           = Optional[int]{}
+	_ gob.GobDecoder           = (*Optional[int])(nil)
+)
+
+type jsonData struct {
+	Value   Optional[int]     `json:"value"`
+	Pointer *Optional[string] `json:"pointer,omitempty"`
+}
+
+func TestJSON(t *testing.T) {
+	t.Run("marshal", func(t *testing.T) {
+		tests := []struct {
+			in   jsonData
+			want string
+		}{
+			{jsonData{*NewOptional(1), NewOptional("foo")}, `{"value":1,"pointer":"foo"}`},
+			{jsonData{*Empty[int](), Empty[string]()}, `{"value":null,"pointer":null}`},
+			{jsonData{}, `{"value":null}`},
+		}
+		for _, tt := range tests {
+			got, err := json.Marshal(tt.in)
+			if err != nil {
+				t.Fatalf("Marshal returns error: %s", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("want=%s, got=%s", tt.want, got)
+			}
+		}
+	})
+
+	t.Run("unmarshal present", func(t *testing.T) {
+		var got jsonData
+		if err := json.Unmarshal([]byte(`{"value":1,"pointer":"foo"}`), &got); err != nil {
+			t.Fatalf("Unmarshal returns error: %s", err)
+		}
+		checkGet(t, &got.Value, 1)
+		checkGet(t, got.Pointer, "foo")
+	})
+
+	t.Run("unmarshal null", func(t *testing.T) {
+		got := jsonData{Value: *NewOptional(1)}
+		if err := json.Unmarshal([]byte(`{"value":null}`), &got); err != nil {
+			t.Fatalf("Unmarshal returns error: %s", err)
+		}
+		if err := got.Value.Error(); err != ErrEmpty {
+			t.Errorf("want=%q, got=%q", ErrEmpty, err)
+		}
+
+		got = jsonData{Pointer: NewOptional("foo")}
+		if err := json.Unmarshal([]byte(`{"pointer":null}`), &got); err != nil {
+			t.Fatalf("Unmarshal returns error: %s", err)
+		}
+		if got.Pointer.IsPresent() || !got.Pointer.IsEmpty() {
+			t.Error("must be empty.")
+		}
+		if _, err := got.Pointer.Get(); err != ErrNoValue {
+			t.Errorf("want=%q, got=%q", ErrNoValue, err)
+		}
+		if err := got.Pointer.Error(); err != ErrEmpty {
+			t.Errorf("want=%q, got=%q", ErrEmpty, err)
+		}
+		if v := got.Pointer.OrElse("bar"); v != "bar" {
+			t.Errorf("want=%q, got=%q", "bar", v)
+		}
+		if s := got.Pointer.Filter(func(string) (bool, error) { return true, nil }).String(); s != "Optional.empty" {
+			t.Errorf("want=%q, got=%q", "Optional.empty", s)
+		}
+
+		o := NewOptional(1)
+		if err := json.Unmarshal([]byte(`null`), o); err != nil {
+			t.Fatalf("Unmarshal returns error: %s", err)
+		}
+		if err := o.Error(); err != ErrEmpty {
+			t.Errorf("want=%q, got=%q", ErrEmpty, err)
+		}
+	})
+
+	t.Run("unmarshal invalid", func(t *testing.T) {
+		o := NewOptional(1)
+		if err := json.Unmarshal([]byte(`"foo"`), o); err == nil {
+			t.Fatal("must return error.")
+		}
+		checkGet(t, o, 1)
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		for _, in := range []*Optional[[]string]{NewOptional([]string{"a", "b"}), Empty[[]string]()} {
+			b, err := json.Marshal(in)
+			if err != nil {
+				t.Fatalf("Marshal returns error: %s", err)
+			}
+			got := &Optional[[]string]{}
+			if err := json.Unmarshal(b, got); err != nil {
+				t.Fatalf("Unmarshal returns error: %s", err)
+			}
+			if !in.Equals(got) {
+				t.Errorf("want=%s, got=%s", in, got)
+			}
+		}
+	})
+}
+
+func TestText(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		check := func(t *testing.T, in, out encoding.TextUnmarshaler, wantText string) {
+			t.Helper()
+			b, err := in.(encoding.TextMarshaler).MarshalText()
+			if err != nil {
+				t.Fatalf("MarshalText returns error: %s", err)
+			}
+			if string(b) != wantText {
+				t.Errorf("want=%q, got=%q", wantText, b)
+			}
+			if err := out.UnmarshalText(b); err != nil {
+				t.Fatalf("UnmarshalText returns error: %s", err)
+			}
+		}
+
+		i := &Optional[int]{}
+		check(t, NewOptional(-1), i, "-1")
+		checkGet(t, i, -1)
+
+		u := &Optional[uint8]{}
+		check(t, NewOptional(uint8(255)), u, "255")
+		checkGet(t, u, 255)
+
+		f := &Optional[float64]{}
+		check(t, NewOptional(1.5), f, "1.5")
+		checkGet(t, f, 1.5)
+
+		b := &Optional[bool]{}
+		check(t, NewOptional(true), b, "true")
+		checkGet(t, b, true)
+
+		s := &Optional[string]{}
+		check(t, NewOptional("foo bar"), s, "foo bar")
+		checkGet(t, s, "foo bar")
+
+		addr := netip.MustParseAddr("192.0.2.1")
+		a := &Optional[netip.Addr]{}
+		check(t, NewOptional(addr), a, "192.0.2.1")
+		checkGet(t, a, addr)
+
+		e := NewOptional(1)
+		check(t, Empty[int](), e, "")
+		if err := e.Error(); err != ErrEmpty {
+			t.Errorf("want=%q, got=%q", ErrEmpty, err)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		if err := (&Optional[int]{}).UnmarshalText([]byte("foo")); err == nil {
+			t.Error("must return error.")
+		}
+		if err := (&Optional[[]int]{}).UnmarshalText([]byte("foo")); err == nil {
+			t.Error("must return error.")
+		}
+	})
+}
+
+type xmlData struct {
+	XMLName xml.Name         `xml:"data"`
+	Value   Optional[int]    `xml:"value"`
+	Name    Optional[string] `xml:"name"`
+}
+
+func TestXML(t *testing.T) {
+	t.Run("marshal", func(t *testing.T) {
+		got, err := xml.Marshal(xmlData{Value: *NewOptional(1), Name: *Empty[string]()})
+		if err != nil {
+			t.Fatalf("Marshal returns error: %s", err)
+		}
+		want := "<data><value>1</value></data>"
+		if string(got) != want {
+			t.Errorf("want=%s, got=%s", want, got)
+		}
+	})
+
+	t.Run("unmarshal", func(t *testing.T) {
+		var got xmlData
+		if err := xml.Unmarshal([]byte("<data><name>foo</name></data>"), &got); err != nil {
+			t.Fatalf("Unmarshal returns error: %s", err)
+		}
+		checkGet(t, &got.Name, "foo")
+		if err := got.Value.Error(); err != ErrEmpty {
+			t.Errorf("want=%q, got=%q", ErrEmpty, err)
+		}
+	})
+}
+
+type gobData struct {
+	Value Optional[int]
+	Names *Optional[[]string]
+}
+
+func TestGob(t *testing.T) {
+	for _, in := range []gobData{
+		{*NewOptional(1), NewOptional([]string{"a"})},
+		{*Empty[int](), Empty[[]string]()},
+	} {
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(in); err != nil {
+			t.Fatalf("Encode returns error: %s", err)
+		}
+		var got gobData
+		if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+			t.Fatalf("Decode returns error: %s", err)
+		}
+		if !in.Value.Equals(&got.Value) {
+			t.Errorf("want=%s, got=%s", &in.Value, &got.Value)
+		}
+		if !in.Names.Equals(got.Names) {
+			t.Errorf("want=%s, got=%s", in.Names, got.Names)
+		}
+		if in.Value.IsEmpty() && got.Value.Error() != ErrEmpty {
+			t.Errorf("want=%q, got=%q", ErrEmpty, got.Value.Error())
+		}
+	}
+}
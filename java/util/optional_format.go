@@ -0,0 +1,52 @@
+package util
+
+import (
+	"fmt"
+	"log/slog"
+	"reflect"
+)
+
+// Format implements [fmt.Formatter]. The verbs are handled as
+// following:
+//   - %v and %s print the same string as [Optional.String].
+//   - %+v prints the same string as %v if the value is present.
+//     Otherwise it also prints [Optional.Error] like
+//     "Optional.empty: Filter at step 1: Predicate returns false".
+//   - %#v prints Go syntax like "util.OfNullable[int](42)" or
+//     "util.Empty[int]()".
+//
+// For other verbs and flags, the value is formatted with them like
+// "Optional[2a]" for %x.
+func (o Optional[T]) Format(f fmt.State, verb rune) {
+	p := o.load()
+	if verb == 'v' && f.Flag('#') {
+		// %T of a zero T prints <nil> if T is an interface.
+		typ := reflect.TypeFor[T]().String()
+		if !p.present {
+			fmt.Fprintf(f, "util.Empty[%s]()", typ)
+			return
+		}
+		fmt.Fprintf(f, "util.OfNullable[%s](%#v)", typ, p.val)
+		return
+	}
+	if !p.present {
+		if verb == 'v' && f.Flag('+') {
+			fmt.Fprintf(f, "Optional.empty: %v", p.Error())
+			return
+		}
+		fmt.Fprint(f, "Optional.empty")
+		return
+	}
+	fmt.Fprintf(f, "Optional["+fmt.FormatString(f, verb)+"]", p.val)
+}
+
+// LogValue implements [slog.LogValuer]. It returns a group of
+// present and value if the value is present. Otherwise it returns a
+// group of present and error.
+func (o Optional[T]) LogValue() slog.Value {
+	p := o.load()
+	if !p.present {
+		return slog.GroupValue(slog.Bool("present", false), slog.Any("error", p.Error()))
+	}
+	return slog.GroupValue(slog.Bool("present", true), slog.Any("value", p.val))
+}
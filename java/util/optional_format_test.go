@@ -0,0 +1,78 @@
+package util
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/dairyo/j2g/java/util/function/supplier"
+)
+
+type point struct{ X, Y int }
+
+func TestFormat(t *testing.T) {
+	odd := func(i int) (bool, error) { return i%2 == 1, nil }
+	tests := []struct {
+		name   string
+		format string
+		o      any
+		want   string
+	}{
+		{"v", "%v", NewOptional(42), "Optional[42]"},
+		{"v value", "%v", *NewOptional(42), "Optional[42]"},
+		{"v empty", "%v", Empty[int](), "Optional.empty"},
+		{"s", "%s", NewOptional("foo"), "Optional[foo]"},
+		{"+v", "%+v", NewOptional(point{1, 2}), "Optional[{X:1 Y:2}]"},
+		{"+v empty", "%+v", NewOptional(2).Filter(odd), "Optional.empty: Filter at step 1: Predicate returns false"},
+		{"#v", "%#v", NewOptional("foo"), `util.OfNullable[string]("foo")`},
+		{"#v empty", "%#v", Empty[point](), "util.Empty[util.point]()"},
+		{"#v empty interface", "%#v", Empty[any](), "util.Empty[interface {}]()"},
+		{"#v interface", "%#v", Of[error](io.EOF), `util.OfNullable[error](&errors.errorString{s:"EOF"})`},
+		{"x", "%x", NewOptional(42), "Optional[2a]"},
+		{"width", "%5d", NewOptional(42), "Optional[   42]"},
+		{"lazy", "%v", Lazy(supplier.WrapNoErr(func() int { return 1 })), "Optional[1]"},
+		{"nil", "%v", (*Optional[int])(nil), "<nil>"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fmt.Sprintf(tt.format, tt.o); got != tt.want {
+				t.Errorf("want=%q, got=%q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestLogValue(t *testing.T) {
+	tests := []struct {
+		name string
+		o    *Optional[int]
+		want string
+	}{
+		{"present", NewOptional(42), `{"present":true,"value":42}`},
+		{"empty", Empty[int](), `{"present":false,"error":"empty optional"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			l := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{
+				ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+					if len(groups) == 0 && a.Key != "o" {
+						return slog.Attr{}
+					}
+					return a
+				},
+			}))
+			l.Info("", "o", tt.o)
+			var got struct{ O json.RawMessage }
+			if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+				t.Fatalf("fail to parse %q: %s", buf.String(), err)
+			}
+			if string(got.O) != tt.want {
+				t.Errorf("want=%s, got=%s", tt.want, got.O)
+			}
+		})
+	}
+}
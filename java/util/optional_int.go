@@ -0,0 +1,64 @@
+package util
+
+import "fmt"
+
+// OptionalInt is a container of an int value. This is a port of
+// java.util.OptionalInt.
+//
+//   - https://docs.oracle.com/en/java/javase/21/docs/api/java.base/java/util/OptionalInt.html
+//   - https://github.com/openjdk/jdk/blob/jdk-21%2B35/src/java.base/share/classes/java/util/OptionalInt.java
+type OptionalInt struct {
+	primitiveOptional[int]
+}
+
+// OfInt returns an [OptionalInt] instance holding value v.
+func OfInt(v int) *OptionalInt {
+	return &OptionalInt{primitiveOptional[int]{val: v, present: true}}
+}
+
+// EmptyInt returns an empty [OptionalInt] instance whose
+// [OptionalInt.Error] returns [ErrEmpty].
+func EmptyInt() *OptionalInt {
+	return &OptionalInt{}
+}
+
+// IntFromOptional converts [Optional] o to [OptionalInt]. If o is
+// empty, the returned instance is also empty and keeps
+// [Optional.Error] of o. If o is nil, an empty instance is returned.
+func IntFromOptional(o *Optional[int]) *OptionalInt {
+	return &OptionalInt{fromOptional(o)}
+}
+
+// GetAsInt returns the value if this instance has a value. Otherwise
+// returns [ErrNoValue].
+func (o *OptionalInt) GetAsInt() (int, error) {
+	return o.get()
+}
+
+// ToOptional converts this instance to [Optional]. The reason of
+// emptiness is kept.
+func (o *OptionalInt) ToOptional() *Optional[int] {
+	return o.toOptional()
+}
+
+// Equals returns true if obj is an *OptionalInt and both are empty
+// or both have equal values.
+func (o *OptionalInt) Equals(obj any) bool {
+	other, ok := obj.(*OptionalInt)
+	if !ok || other == nil {
+		return false
+	}
+	if o.present != other.present {
+		return false
+	}
+	return !o.present || o.val == other.val
+}
+
+// String returns "OptionalInt[v]" if this instance has value v.
+// Otherwise returns "OptionalInt.empty".
+func (o *OptionalInt) String() string {
+	if !o.present {
+		return "OptionalInt.empty"
+	}
+	return fmt.Sprintf("OptionalInt[%v]", o.val)
+}
@@ -0,0 +1,28 @@
+package util
+
+import "iter"
+
+// All returns a sequence which yields the value of this Optional if
+// [Optional.IsPresent] is true. Otherwise the sequence yields
+// nothing. This is a replacement of java Optional's stream.
+func (o *Optional[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		o := o.load()
+		if o.present {
+			yield(o.val)
+		}
+	}
+}
+
+// FromSeq returns an [Optional] instance holding the first element of
+// seq. The rest of seq is not consumed. If seq is nil or yields
+// nothing, FromSeq returns empty [Optional]. If the first element is
+// nil, FromSeq returns empty [Optional] as [OfNullable] does.
+func FromSeq[T any](seq iter.Seq[T]) *Optional[T] {
+	if seq != nil {
+		for v := range seq {
+			return OfNullable(v)
+		}
+	}
+	return Empty[T]()
+}
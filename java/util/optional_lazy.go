@@ -0,0 +1,111 @@
+package util
+
+import (
+	"errors"
+	"runtime/debug"
+	"sync"
+
+	"github.com/dairyo/j2g/java/util/function"
+	"github.com/dairyo/j2g/java/util/function/supplier"
+)
+
+// lazy is the state of a lazy Optional. fill is called at most once
+// to resolve res. If fill panics, res becomes empty with a
+// [*function.PanicError] and panicked holds the value passed to
+// panic.
+type lazy[T any] struct {
+	once     sync.Once
+	fill     func(*Optional[T])
+	res      Optional[T]
+	panicked any
+}
+
+// get resolves l and returns res. If fill panics, get panics with the
+// same value unless rec is true, in which case res holding the
+// [*function.PanicError] is returned.
+func (l *lazy[T]) get(rec bool) *Optional[T] {
+	l.once.Do(l.resolve)
+	if l.panicked != nil && !rec {
+		panic(l.panicked)
+	}
+	return &l.res
+}
+
+func (l *lazy[T]) resolve() {
+	defer func() {
+		l.fill = nil
+		if v := recover(); v != nil {
+			var zero T
+			l.res.val = zero
+			l.res.present = false
+			l.res.err = &function.PanicError{Value: v, Stack: debug.Stack()}
+			l.panicked = v
+		}
+	}()
+	l.fill(&l.res)
+}
+
+// Lazy returns an [Optional] instance holding the value produced by
+// [supplier.Supplier] s. s is not called until the Optional is read
+// by a method such as [Optional.Get], [Optional.IsPresent] or
+// [Optional.IfPresent]. s is called at most once even if the
+// Optional is read concurrently, and its result is cached.
+//
+// If s panics, the panic is cached as well. Every read of the
+// Optional panics with the same value, unless the Optional is
+// returned by [Optional.WithRecover], in which case the Optional is
+// empty and its [Optional.Error] returns a *PanicError of package
+// github.com/dairyo/j2g/java/util/function.
+//
+// If s returns error, the Optional is empty and its [Optional.Error]
+// returns an error which contains [ErrSupplierErr] and the error
+// returned by s. If s produces nil, the Optional is empty as
+// [OfNullable]. If s is nil, the Optional is empty and its
+// [Optional.Error] returns [ErrNilSupplier].
+//
+// [Map], [FlatMap], [Optional.Filter] and [Optional.Or] and their
+// context-aware variants on a lazy Optional return lazy Optionals,
+// so their callbacks are not called until the result is read.
+func Lazy[T any](s supplier.Supplier[T]) *Optional[T] {
+	return &Optional[T]{lazy: &lazy[T]{fill: func(r *Optional[T]) {
+		if s == nil {
+			r.err = ErrNilSupplier
+			return
+		}
+		v, err := s()
+		if err != nil {
+			r.err = errors.Join(ErrSupplierErr, err)
+			return
+		}
+		*r = *OfNullable(v)
+	}}}
+}
+
+// load returns the resolved Optional of o. If o is not lazy, load
+// returns o itself. If o is nil, load returns an empty Optional so
+// that a nil *Optional, such as a pointer field set to nil by
+// encoding/json for null, is read as empty with [ErrEmpty].
+func (o *Optional[T]) load() *Optional[T] {
+	if o == nil {
+		return &Optional[T]{}
+	}
+	if !o.isLazy() {
+		return o
+	}
+	return o.lazy.get(o.recover)
+}
+
+func (o *Optional[T]) isLazy() bool {
+	return o != nil && o.lazy != nil
+}
+
+// deferInto makes r a lazy Optional which is resolved by calling
+// fill with the resolved v. v must be lazy. If r already recovers
+// panics, a panic in resolving v is recovered as well.
+func deferInto[T, U any](r *Optional[U], v *Optional[T], fill func(r *Optional[U], v *Optional[T])) {
+	l := v.lazy
+	r.step = v.step + 1
+	r.recover = r.recover || v.recover
+	rec := r.recover
+	r.lazy = &lazy[U]{fill: func(r *Optional[U]) { fill(r, l.get(rec)) }}
+}
@@ -0,0 +1,172 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/dairyo/j2g/java/util/function/function"
+	"github.com/dairyo/j2g/java/util/function/supplier"
+)
+
+// countingSupplier returns a Supplier which counts calls in n.
+func countingSupplier[T any](n *atomic.Int32, v T, err error) supplier.Supplier[T] {
+	return func() (T, error) {
+		n.Add(1)
+		return v, err
+	}
+}
+
+func checkCalls(t *testing.T, n *atomic.Int32, want int32) {
+	t.Helper()
+	if got := n.Load(); got != want {
+		t.Errorf("want=%d calls, got=%d calls", want, got)
+	}
+}
+
+func TestLazy(t *testing.T) {
+	t.Run("present", func(t *testing.T) {
+		var n atomic.Int32
+		o := Lazy(countingSupplier(&n, 1, nil))
+		checkCalls(t, &n, 0)
+		checkGet(t, o, 1)
+		if !o.IsPresent() || o.IsEmpty() {
+			t.Error("must be present.")
+		}
+		if err := o.IfPresent(func(int) error { return nil }); err != nil {
+			t.Errorf("must not return error but %q.", err)
+		}
+		checkCalls(t, &n, 1)
+	})
+
+	t.Run("supplier error is cached", func(t *testing.T) {
+		var n atomic.Int32
+		e := errors.New("test")
+		o := Lazy(countingSupplier(&n, 0, e))
+		for range 2 {
+			err := o.Error()
+			if !errors.Is(err, ErrSupplierErr) || !errors.Is(err, e) {
+				t.Errorf("error must contain ErrSupplierErr and %q but %q.", e, err)
+			}
+		}
+		checkCalls(t, &n, 1)
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		if err := Lazy[int](nil).Error(); !errors.Is(err, ErrNilSupplier) {
+			t.Errorf("want=%q, got=%q", ErrNilSupplier, err)
+		}
+		var p *int
+		if err := Lazy(supplier.WrapNoErr(func() *int { return p })).Error(); !errors.Is(err, ErrEmpty) {
+			t.Errorf("want=%q, got=%q", ErrEmpty, err)
+		}
+	})
+
+	t.Run("operations stay lazy", func(t *testing.T) {
+		var n, m atomic.Int32
+		o := Lazy(countingSupplier(&n, 1, nil))
+		double := func(v int) (int, error) {
+			m.Add(1)
+			return v * 2, nil
+		}
+		r := Map(o, double).
+			Filter(func(v int) (bool, error) { return v > 0, nil }).
+			Or(func() (*Optional[int], error) { return nil, nil })
+		r = FlatMap(r, func(v int) (*Optional[int], error) { return Map(Lazy(countingSupplier(&n, v, nil)), double), nil })
+		r = r.WithRecover()
+		checkCalls(t, &n, 0)
+		checkCalls(t, &m, 0)
+		checkGet(t, r, 4)
+		checkCalls(t, &n, 2)
+		checkCalls(t, &m, 2)
+		if r.step != 4 {
+			t.Errorf("want=%d, got=%d", 4, r.step)
+		}
+		if !r.recover {
+			t.Error("must recover.")
+		}
+		checkGet(t, o, 1)
+		checkCalls(t, &n, 2)
+	})
+
+	t.Run("empty reason", func(t *testing.T) {
+		o := Map(Lazy(supplier.WrapNoErr(func() int { return 1 })), function.WrapNoErr(func(v int) int { return v }))
+		o = o.Filter(func(int) (bool, error) { return false, nil })
+		checkReason(t, o.Error(), OpFilter, 2)
+	})
+
+	t.Run("context", func(t *testing.T) {
+		var n atomic.Int32
+		ctx, cancel := context.WithCancel(context.Background())
+		o := MapCtx(ctx, Lazy(countingSupplier(&n, 1, nil)), func(_ context.Context, v int) (int, error) { return v, nil })
+		o = o.FilterCtx(ctx, func(context.Context, int) (bool, error) { return true, nil })
+		cancel()
+		checkCalls(t, &n, 0)
+		reason := checkReason(t, o.Error(), OpMap, 1)
+		if !errors.Is(reason.Cause, context.Canceled) {
+			t.Errorf("want=%q, got=%q", context.Canceled, reason.Cause)
+		}
+		checkCalls(t, &n, 1)
+	})
+
+	t.Run("supplier panic is cached", func(t *testing.T) {
+		var n atomic.Int32
+		o := Lazy(func() (int, error) {
+			n.Add(1)
+			panic("boom")
+		})
+		m := Map(o, function.WrapNoErr(func(v int) int { return v }))
+		for _, read := range []func(){
+			func() { o.Get() },
+			func() { o.IsPresent() },
+			func() { o.Error() },
+			func() { m.Get() },
+		} {
+			func() {
+				defer func() {
+					if v := recover(); v != "boom" {
+						t.Errorf("want=%q, got=%v", "boom", v)
+					}
+				}()
+				read()
+			}()
+		}
+		checkCalls(t, &n, 1)
+	})
+
+	t.Run("supplier panic with WithRecover", func(t *testing.T) {
+		var n atomic.Int32
+		o := Lazy(func() (int, error) {
+			n.Add(1)
+			panic("boom")
+		}).WithRecover()
+		for range 2 {
+			if o.IsPresent() {
+				t.Error("must be empty.")
+			}
+			checkPanicError(t, o.Error())
+		}
+		m := Map(o, function.WrapNoErr(func(v int) int { return v }))
+		checkPanicError(t, m.Error())
+		checkCalls(t, &n, 1)
+	})
+
+	t.Run("concurrent", func(t *testing.T) {
+		var n atomic.Int32
+		o := Map(Lazy(countingSupplier(&n, 1, nil)), function.WrapNoErr(func(v int) int { return v + 1 }))
+		var wg sync.WaitGroup
+		for range 16 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if v, err := o.Get(); v != 2 || err != nil {
+					t.Errorf("want=%d, got=%d, %v", 2, v, err)
+				}
+			}()
+		}
+		wg.Wait()
+		checkCalls(t, &n, 1)
+	})
+}
@@ -0,0 +1,64 @@
+package util
+
+import "fmt"
+
+// OptionalLong is a container of an int64 value. This is a port of
+// java.util.OptionalLong.
+//
+//   - https://docs.oracle.com/en/java/javase/21/docs/api/java.base/java/util/OptionalLong.html
+//   - https://github.com/openjdk/jdk/blob/jdk-21%2B35/src/java.base/share/classes/java/util/OptionalLong.java
+type OptionalLong struct {
+	primitiveOptional[int64]
+}
+
+// OfLong returns an [OptionalLong] instance holding value v.
+func OfLong(v int64) *OptionalLong {
+	return &OptionalLong{primitiveOptional[int64]{val: v, present: true}}
+}
+
+// EmptyLong returns an empty [OptionalLong] instance whose
+// [OptionalLong.Error] returns [ErrEmpty].
+func EmptyLong() *OptionalLong {
+	return &OptionalLong{}
+}
+
+// LongFromOptional converts [Optional] o to [OptionalLong]. If o is
+// empty, the returned instance is also empty and keeps
+// [Optional.Error] of o. If o is nil, an empty instance is returned.
+func LongFromOptional(o *Optional[int64]) *OptionalLong {
+	return &OptionalLong{fromOptional(o)}
+}
+
+// GetAsLong returns the value if this instance has a value. Otherwise
+// returns [ErrNoValue].
+func (o *OptionalLong) GetAsLong() (int64, error) {
+	return o.get()
+}
+
+// ToOptional converts this instance to [Optional]. The reason of
+// emptiness is kept.
+func (o *OptionalLong) ToOptional() *Optional[int64] {
+	return o.toOptional()
+}
+
+// Equals returns true if obj is an *OptionalLong and both are empty
+// or both have equal values.
+func (o *OptionalLong) Equals(obj any) bool {
+	other, ok := obj.(*OptionalLong)
+	if !ok || other == nil {
+		return false
+	}
+	if o.present != other.present {
+		return false
+	}
+	return !o.present || o.val == other.val
+}
+
+// String returns "OptionalLong[v]" if this instance has value v.
+// Otherwise returns "OptionalLong.empty".
+func (o *OptionalLong) String() string {
+	if !o.present {
+		return "OptionalLong.empty"
+	}
+	return fmt.Sprintf("OptionalLong[%v]", o.val)
+}
@@ -0,0 +1,145 @@
+package util
+
+import (
+	"errors"
+
+	"github.com/dairyo/j2g/java/lang/runnable"
+	"github.com/dairyo/j2g/java/util/function/consumer"
+	"github.com/dairyo/j2g/java/util/function/supplier"
+)
+
+type primitive interface {
+	int | int64 | float64
+}
+
+// primitiveOptional is the common part of [OptionalInt],
+// [OptionalLong] and [OptionalDouble]. The methods follow the same
+// error semantics as [Optional].
+type primitiveOptional[T primitive] struct {
+	val     T
+	err     error
+	present bool
+}
+
+// IsPresent returns true if this instance has a value. Otherwise
+// return false.
+func (o *primitiveOptional[T]) IsPresent() bool {
+	return o.present
+}
+
+// IsEmpty returns true if this instance does not have a
+// value. Otherwise return false.
+func (o *primitiveOptional[T]) IsEmpty() bool {
+	return !o.present
+}
+
+// Error returns the reason why this instance is empty. If this
+// instance has a value, Error returns nil.
+func (o *primitiveOptional[T]) Error() error {
+	if o.present {
+		return nil
+	}
+	if o.err == nil {
+		return ErrEmpty
+	}
+	return o.err
+}
+
+// IfPresent executes [consumer.Consumer] c if this instance has a
+// value. It returns the same errors as [Optional.IfPresent].
+func (o *primitiveOptional[T]) IfPresent(c consumer.Consumer[T]) error {
+	if !o.present {
+		return ErrNoValue
+	}
+	if c == nil {
+		return ErrNilConsumer
+	}
+	return c(o.val)
+}
+
+// IfPresentOrElse executes [consumer.Consumer] c if this instance
+// has a value. Otherwise executes [runnable.Runnable] r. It returns
+// the same errors as [Optional.IfPresentOrElse].
+func (o *primitiveOptional[T]) IfPresentOrElse(c consumer.Consumer[T], r runnable.Runnable) error {
+	if o.present {
+		return o.IfPresent(c)
+	}
+	if r == nil {
+		return ErrInvalidUsed
+	}
+	return r()
+}
+
+// OrElse returns the value if this instance has a value. Otherwise
+// returns other.
+func (o *primitiveOptional[T]) OrElse(other T) T {
+	if o.present {
+		return o.val
+	}
+	return other
+}
+
+// OrElseGet returns the value if this instance has a value.
+// Otherwise returns the value produced by [supplier.Supplier] s. It
+// returns the same errors as [Optional.OrElseGet].
+func (o *primitiveOptional[T]) OrElseGet(s supplier.Supplier[T]) (T, error) {
+	if o.present {
+		if s == nil {
+			return 0, ErrNilSupplier
+		}
+		return o.val, nil
+	}
+	if s == nil {
+		return 0, errors.Join(ErrNilSupplier, o.Error())
+	}
+	ret, err := s()
+	if err != nil {
+		return 0, errors.Join(ErrSupplierErr, o.Error(), err)
+	}
+	return ret, nil
+}
+
+// OrElseErr returns the value if this instance has a value.
+// Otherwise returns the error produced by [supplier.Supplier] s. It
+// returns the same errors as [Optional.OrElseErr].
+func (o *primitiveOptional[T]) OrElseErr(s supplier.Supplier[error]) (T, error) {
+	if o.present {
+		if s == nil {
+			return 0, ErrNilSupplier
+		}
+		return o.val, nil
+	}
+	if s == nil {
+		return 0, errors.Join(ErrNilSupplier, o.Error())
+	}
+	ret, err := s()
+	if err != nil {
+		return 0, errors.Join(ErrSupplierErr, o.Error(), err)
+	}
+	if ret == nil {
+		return 0, ErrNoValue
+	}
+	return 0, ret
+}
+
+func (o *primitiveOptional[T]) get() (T, error) {
+	if !o.present {
+		return 0, ErrNoValue
+	}
+	return o.val, nil
+}
+
+func (o *primitiveOptional[T]) toOptional() *Optional[T] {
+	return &Optional[T]{val: o.val, err: o.err, present: o.present}
+}
+
+func fromOptional[T primitive](o *Optional[T]) primitiveOptional[T] {
+	if o == nil {
+		return primitiveOptional[T]{err: ErrEmpty}
+	}
+	o = o.load()
+	if !o.present {
+		return primitiveOptional[T]{err: o.Error()}
+	}
+	return primitiveOptional[T]{val: o.val, present: true}
+}
@@ -0,0 +1,195 @@
+package util
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestOptionalInt(t *testing.T) {
+	t.Run("present", func(t *testing.T) {
+		o := OfInt(1)
+		if !o.IsPresent() || o.IsEmpty() {
+			t.Fatal("must be present.")
+		}
+		got, err := o.GetAsInt()
+		if err != nil {
+			t.Fatalf("GetAsInt returns error: %s", err)
+		}
+		if got != 1 {
+			t.Errorf("want=1, got=%d", got)
+		}
+		if got := o.OrElse(2); got != 1 {
+			t.Errorf("want=1, got=%d", got)
+		}
+		if err := o.Error(); err != nil {
+			t.Errorf("must not return error but %q.", err)
+		}
+		if got := o.String(); got != "OptionalInt[1]" {
+			t.Errorf("want=%q, got=%q", "OptionalInt[1]", got)
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		o := EmptyInt()
+		if o.IsPresent() || !o.IsEmpty() {
+			t.Fatal("must be empty.")
+		}
+		if _, err := o.GetAsInt(); err != ErrNoValue {
+			t.Errorf("want=%q, got=%q", ErrNoValue, err)
+		}
+		if got := o.OrElse(2); got != 2 {
+			t.Errorf("want=2, got=%d", got)
+		}
+		if err := o.Error(); err != ErrEmpty {
+			t.Errorf("want=%q, got=%q", ErrEmpty, err)
+		}
+		if got := o.String(); got != "OptionalInt.empty" {
+			t.Errorf("want=%q, got=%q", "OptionalInt.empty", got)
+		}
+	})
+
+	t.Run("IfPresent", func(t *testing.T) {
+		c := newConsumerCalled(t, 1, nil)
+		if err := OfInt(1).IfPresent(c.consume); err != nil {
+			t.Errorf("should not return error but %q.", err)
+		}
+		c.checkCalled()
+
+		if err := OfInt(1).IfPresent(nil); err != ErrNilConsumer {
+			t.Errorf("want=%q, got=%q", ErrNilConsumer, err)
+		}
+
+		c = newConsumerCalled(t, 1, nil)
+		if err := EmptyInt().IfPresent(c.consume); err != ErrNoValue {
+			t.Errorf("want=%q, got=%q", ErrNoValue, err)
+		}
+		c.checkNotCalled()
+	})
+
+	t.Run("IfPresentOrElse", func(t *testing.T) {
+		c := newConsumerCalled(t, 1, nil)
+		r := newRunnableCalled(t, nil)
+		if err := OfInt(1).IfPresentOrElse(c.consume, r.run); err != nil {
+			t.Errorf("should not return error but %q.", err)
+		}
+		c.checkCalled()
+		r.checkNotCalled()
+
+		want := errors.New("foo")
+		c = newConsumerCalled(t, 1, nil)
+		r = newRunnableCalled(t, want)
+		if err := EmptyInt().IfPresentOrElse(c.consume, r.run); err != want {
+			t.Errorf("want=%q, got=%q", want, err)
+		}
+		c.checkNotCalled()
+		r.checkCalled()
+
+		if err := EmptyInt().IfPresentOrElse(c.consume, nil); err != ErrInvalidUsed {
+			t.Errorf("want=%q, got=%q", ErrInvalidUsed, err)
+		}
+	})
+
+	t.Run("OrElseGet", func(t *testing.T) {
+		got, err := EmptyInt().OrElseGet(func() (int, error) { return 2, nil })
+		if err != nil || got != 2 {
+			t.Errorf("want=(2, nil), got=(%d, %v)", got, err)
+		}
+		want := errors.New("foo")
+		_, err = EmptyInt().OrElseGet(func() (int, error) { return 0, want })
+		if !errors.Is(err, ErrSupplierErr) || !errors.Is(err, want) {
+			t.Errorf("error must contain %q and %q but %q.", ErrSupplierErr, want, err)
+		}
+		if _, err := OfInt(1).OrElseGet(nil); err != ErrNilSupplier {
+			t.Errorf("want=%q, got=%q", ErrNilSupplier, err)
+		}
+	})
+
+	t.Run("OrElseErr", func(t *testing.T) {
+		want := errors.New("foo")
+		if _, err := EmptyInt().OrElseErr(func() (error, error) { return want, nil }); err != want {
+			t.Errorf("want=%q, got=%q", want, err)
+		}
+		got, err := OfInt(1).OrElseErr(func() (error, error) { return want, nil })
+		if err != nil || got != 1 {
+			t.Errorf("want=(1, nil), got=(%d, %v)", got, err)
+		}
+	})
+
+	t.Run("Equals", func(t *testing.T) {
+		if !OfInt(1).Equals(OfInt(1)) {
+			t.Error("same values must be equal.")
+		}
+		if OfInt(1).Equals(OfInt(2)) || OfInt(1).Equals(EmptyInt()) {
+			t.Error("must not be equal.")
+		}
+		if !EmptyInt().Equals(EmptyInt()) {
+			t.Error("empty instances must be equal.")
+		}
+		if OfInt(1).Equals(OfLong(1)) {
+			t.Error("different types must not be equal.")
+		}
+	})
+
+	t.Run("conversion", func(t *testing.T) {
+		checkGet(t, OfInt(1).ToOptional(), 1)
+		if got, _ := IntFromOptional(NewOptional(1)).GetAsInt(); got != 1 {
+			t.Errorf("want=1, got=%d", got)
+		}
+		e := IntFromOptional(NewOptional(1).Filter(func(int) (bool, error) { return false, nil }))
+		if err := e.Error(); !errors.Is(err, ErrPredicateFailed) {
+			t.Errorf("want=%q, got=%q", ErrPredicateFailed, err)
+		}
+		if err := e.ToOptional().Error(); !errors.Is(err, ErrPredicateFailed) {
+			t.Errorf("want=%q, got=%q", ErrPredicateFailed, err)
+		}
+		if err := IntFromOptional(nil).Error(); err != ErrEmpty {
+			t.Errorf("want=%q, got=%q", ErrEmpty, err)
+		}
+	})
+}
+
+func TestOptionalLong(t *testing.T) {
+	o := OfLong(math.MaxInt64)
+	got, err := o.GetAsLong()
+	if err != nil {
+		t.Fatalf("GetAsLong returns error: %s", err)
+	}
+	if got != math.MaxInt64 {
+		t.Errorf("want=%d, got=%d", int64(math.MaxInt64), got)
+	}
+	if got := o.String(); got != "OptionalLong[9223372036854775807]" {
+		t.Errorf("want=%q, got=%q", "OptionalLong[9223372036854775807]", got)
+	}
+	if _, err := EmptyLong().GetAsLong(); err != ErrNoValue {
+		t.Errorf("want=%q, got=%q", ErrNoValue, err)
+	}
+	if got := EmptyLong().String(); got != "OptionalLong.empty" {
+		t.Errorf("want=%q, got=%q", "OptionalLong.empty", got)
+	}
+	checkGet(t, LongFromOptional(NewOptional(int64(1))).ToOptional(), 1)
+}
+
+func TestOptionalDouble(t *testing.T) {
+	o := OfDouble(1.5)
+	got, err := o.GetAsDouble()
+	if err != nil {
+		t.Fatalf("GetAsDouble returns error: %s", err)
+	}
+	if got != 1.5 {
+		t.Errorf("want=1.5, got=%v", got)
+	}
+	if got := o.String(); got != "OptionalDouble[1.5]" {
+		t.Errorf("want=%q, got=%q", "OptionalDouble[1.5]", got)
+	}
+	if _, err := EmptyDouble().GetAsDouble(); err != ErrNoValue {
+		t.Errorf("want=%q, got=%q", ErrNoValue, err)
+	}
+	if !OfDouble(math.NaN()).Equals(OfDouble(math.NaN())) {
+		t.Error("NaN must be equal to NaN.")
+	}
+	if OfDouble(0).Equals(OfDouble(math.Copysign(0, -1))) {
+		t.Error("0.0 must not be equal to -0.0.")
+	}
+	checkGet(t, DoubleFromOptional(NewOptional(1.5)).ToOptional(), 1.5)
+}
@@ -0,0 +1,93 @@
+package util
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dairyo/j2g/java/util/function"
+)
+
+func checkPanicError(t *testing.T, err error) {
+	t.Helper()
+	var pe *function.PanicError
+	if !errors.As(err, &pe) {
+		t.Fatalf("error must contain PanicError but %q.", err)
+	}
+	if pe.Value != "boom" {
+		t.Errorf("want=%q, got=%v", "boom", pe.Value)
+	}
+}
+
+func TestWithRecover(t *testing.T) {
+	boomMap := func(int) (int, error) { panic("boom") }
+
+	t.Run("Map", func(t *testing.T) {
+		o := Map(Map(NewOptional(1).WithRecover(), func(v int) (int, error) { return v, nil }), boomMap)
+		checkReason(t, o.Error(), OpMap, 2)
+		checkPanicError(t, o.Error())
+
+		o2 := FlatMap(NewOptional(1).WithRecover(), func(int) (*Optional[int], error) { panic("boom") })
+		checkPanicError(t, o2.Error())
+	})
+
+	t.Run("Filter", func(t *testing.T) {
+		o := NewOptional(1).WithRecover().Filter(func(int) (bool, error) { panic("boom") })
+		checkReason(t, o.Error(), OpFilter, 1)
+		checkPanicError(t, o.Error())
+	})
+
+	t.Run("Or", func(t *testing.T) {
+		o := Empty[int]().WithRecover().Or(func() (*Optional[int], error) { panic("boom") })
+		checkReason(t, o.Error(), OpOr, 1)
+		checkPanicError(t, o.Error())
+
+		o = Empty[int]().WithRecover().Or(func() (*Optional[int], error) { return NewOptional(1), nil })
+		o = Map(o, boomMap)
+		checkPanicError(t, o.Error())
+	})
+
+	t.Run("ZipWith", func(t *testing.T) {
+		o := ZipWith(NewOptional(1).WithRecover(), NewOptional(2), func(int, int) (int, error) { panic("boom") })
+		checkReason(t, o.Error(), OpZip, 1)
+		checkPanicError(t, o.Error())
+	})
+
+	t.Run("methods returning error", func(t *testing.T) {
+		o := NewOptional(1).WithRecover()
+		checkPanicError(t, o.IfPresent(func(int) error { panic("boom") }))
+		checkPanicError(t, o.IfPresentOrElse(func(int) error { panic("boom") }, nil))
+		e := Empty[int]().WithRecover()
+		checkPanicError(t, e.IfPresentOrElse(nil, func() error { panic("boom") }))
+		_, err := e.OrElseGet(func() (int, error) { panic("boom") })
+		checkPanicError(t, err)
+		_, err = e.OrElseErr(func() (error, error) { panic("boom") })
+		checkPanicError(t, err)
+	})
+
+	t.Run("not recovering by default", func(t *testing.T) {
+		defer func() {
+			if v := recover(); v != "boom" {
+				t.Errorf("must panic with boom but %v", v)
+			}
+		}()
+		Map(NewOptional(1), boomMap)
+	})
+
+	t.Run("WithRecover does not change original", func(t *testing.T) {
+		o := NewOptional(1)
+		checkGet(t, o.WithRecover(), 1)
+		if o.recover {
+			t.Error("must not change the original.")
+		}
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		var n *Optional[int]
+		o := n.WithRecover()
+		if err := o.Error(); err != ErrEmpty {
+			t.Errorf("want=%q, got=%q", ErrEmpty, err)
+		}
+		o = o.Or(func() (*Optional[int], error) { panic("boom") })
+		checkPanicError(t, o.Error())
+	})
+}
@@ -0,0 +1,81 @@
+package util
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// Scan implements [sql.Scanner]. SQL NULL is scanned as an empty
+// Optional whose [Optional.Error] returns [ErrEmpty]. Other values
+// are converted to T and the Optional holds it. If *T implements
+// [sql.Scanner], its Scan method is used for the conversion.
+//
+// Scan does not return an error when src can not be converted to T.
+// Instead the Optional becomes empty and [Optional.Error] returns an
+// error which contains [ErrScan], so that one unexpected column does
+// not make the whole [sql.Rows.Scan] fail.
+func (o *Optional[T]) Scan(src any) error {
+	if src == nil {
+		*o = Optional[T]{err: ErrEmpty}
+		return nil
+	}
+	var v T
+	if err := scanValue(&v, src); err != nil {
+		*o = Optional[T]{err: errors.Join(ErrScan, err)}
+		return nil
+	}
+	*o = Optional[T]{val: v, present: true}
+	return nil
+}
+
+// Value implements [driver.Valuer]. An empty Optional is SQL NULL. A
+// present value is converted with [driver.DefaultParameterConverter].
+func (o Optional[T]) Value() (driver.Value, error) {
+	o = *o.load()
+	if !o.present {
+		return nil, nil
+	}
+	return driver.DefaultParameterConverter.ConvertValue(o.val)
+}
+
+func scanValue[T any](dst *T, src any) error {
+	// The driver owns the memory of a []byte source, so it is copied
+	// before it can be stored in dst by any of the conversions below.
+	if b, ok := src.([]byte); ok {
+		src = bytes.Clone(b)
+	}
+	if s, ok := any(dst).(sql.Scanner); ok {
+		return s.Scan(src)
+	}
+	if v, ok := src.(T); ok {
+		*dst = v
+		return nil
+	}
+	dv := reflect.ValueOf(dst).Elem()
+	switch s := src.(type) {
+	case []byte:
+		if dv.Kind() == reflect.Slice && dv.Type().Elem().Kind() == reflect.Uint8 {
+			dv.SetBytes(s)
+			return nil
+		}
+		return parseText(dv, string(s))
+	case string:
+		if dv.Kind() == reflect.Slice && dv.Type().Elem().Kind() == reflect.Uint8 {
+			dv.SetBytes([]byte(s))
+			return nil
+		}
+		return parseText(dv, s)
+	case int64, float64, bool:
+		return parseText(dv, fmt.Sprint(s))
+	}
+	sv := reflect.ValueOf(src)
+	if sv.Type().ConvertibleTo(dv.Type()) {
+		dv.Set(sv.Convert(dv.Type()))
+		return nil
+	}
+	return fmt.Errorf("can not convert %T to %s", src, dv.Type())
+}
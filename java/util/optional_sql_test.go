@@ -0,0 +1,187 @@
+package util
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeDriver is an in-memory database/sql driver. Every statement
+// executed with Exec appends its arguments to the table as a row and
+// every statement executed with Query returns all rows in the table.
+type fakeDriver struct {
+	mu   sync.Mutex
+	rows [][]driver.Value
+}
+
+func (d *fakeDriver) Open(string) (driver.Conn, error) { return &fakeConn{d}, nil }
+
+type fakeConn struct{ d *fakeDriver }
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) { return &fakeStmt{c.d}, nil }
+func (c *fakeConn) Close() error                        { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error)           { return nil, errors.New("not supported") }
+
+type fakeStmt struct{ d *fakeDriver }
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+	s.d.rows = append(s.d.rows, args)
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+	rows := make([][]driver.Value, len(s.d.rows))
+	copy(rows, s.d.rows)
+	s.d.rows = nil
+	return &fakeRows{rows: rows}, nil
+}
+
+type fakeRows struct {
+	rows [][]driver.Value
+	i    int
+}
+
+func (r *fakeRows) Columns() []string { return []string{"v"} }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.i >= len(r.rows) {
+		return io.EOF
+	}
+	copy(dest, r.rows[r.i])
+	r.i++
+	return nil
+}
+
+var fakeDriverOnce sync.Once
+
+func openFakeDB(t *testing.T) *sql.DB {
+	t.Helper()
+	fakeDriverOnce.Do(func() { sql.Register("optionalfake", &fakeDriver{}) })
+	db, err := sql.Open("optionalfake", "")
+	if err != nil {
+		t.Fatalf("Open returns error: %s", err)
+	}
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+// roundTrip inserts in as a row and scans it to out.
+func roundTrip(t *testing.T, db *sql.DB, in any, out sql.Scanner) {
+	t.Helper()
+	if _, err := db.Exec("insert", in); err != nil {
+		t.Fatalf("Exec returns error: %s", err)
+	}
+	if err := db.QueryRow("select").Scan(out); err != nil {
+		t.Fatalf("Scan returns error: %s", err)
+	}
+}
+
+func TestSQL(t *testing.T) {
+	db := openFakeDB(t)
+
+	t.Run("present", func(t *testing.T) {
+		s := &Optional[string]{}
+		roundTrip(t, db, NewOptional("foo"), s)
+		checkGet(t, s, "foo")
+
+		i := &Optional[int]{}
+		roundTrip(t, db, NewOptional(1), i)
+		checkGet(t, i, 1)
+
+		f := &Optional[float32]{}
+		roundTrip(t, db, NewOptional(float32(1.5)), f)
+		checkGet(t, f, 1.5)
+
+		now := time.Now()
+		tm := &Optional[time.Time]{}
+		roundTrip(t, db, NewOptional(now), tm)
+		checkGet(t, tm, now)
+	})
+
+	t.Run("NULL", func(t *testing.T) {
+		o := NewOptional("foo")
+		roundTrip(t, db, Empty[string](), o)
+		if err := o.Error(); err != ErrEmpty {
+			t.Errorf("want=%q, got=%q", ErrEmpty, err)
+		}
+	})
+
+	t.Run("conversion", func(t *testing.T) {
+		s := &Optional[string]{}
+		roundTrip(t, db, 1, s)
+		checkGet(t, s, "1")
+
+		i := &Optional[int8]{}
+		roundTrip(t, db, "12", i)
+		checkGet(t, i, 12)
+
+		b := &Optional[[]byte]{}
+		roundTrip(t, db, "foo", b)
+		got, _ := b.Get()
+		if string(got) != "foo" {
+			t.Errorf("want=%q, got=%q", "foo", got)
+		}
+
+		n := &Optional[sql.NullInt64]{}
+		roundTrip(t, db, 1, n)
+		checkGet(t, n, sql.NullInt64{Int64: 1, Valid: true})
+	})
+
+	t.Run("copy bytes", func(t *testing.T) {
+		buf := []byte("hello")
+		b := &Optional[[]byte]{}
+		b.Scan(buf)
+		a := &Optional[any]{}
+		a.Scan(buf)
+		buf[0] = 'X'
+		got, _ := b.Get()
+		if string(got) != "hello" {
+			t.Errorf("want=%q, got=%q", "hello", got)
+		}
+		v, _ := a.Get()
+		if got, _ := v.([]byte); string(got) != "hello" {
+			t.Errorf("want=%q, got=%q", "hello", v)
+		}
+	})
+
+	t.Run("type mismatch", func(t *testing.T) {
+		i := NewOptional(1)
+		roundTrip(t, db, "foo", i)
+		if !errors.Is(i.Error(), ErrScan) {
+			t.Errorf("error must contain %q but %q.", ErrScan, i.Error())
+		}
+
+		overflow := &Optional[int8]{}
+		roundTrip(t, db, 1000, overflow)
+		if !errors.Is(overflow.Error(), ErrScan) {
+			t.Errorf("error must contain %q but %q.", ErrScan, overflow.Error())
+		}
+	})
+
+	t.Run("Value", func(t *testing.T) {
+		v, err := Empty[int]().Value()
+		if v != nil || err != nil {
+			t.Errorf("want=(nil, nil), got=(%v, %v)", v, err)
+		}
+		v, err = NewOptional(int32(1)).Value()
+		if v != int64(1) || err != nil {
+			t.Errorf("want=(1, nil), got=(%v, %v)", v, err)
+		}
+		if _, err := NewOptional(struct{}{}).Value(); err == nil {
+			t.Error("must return error.")
+		}
+	})
+}
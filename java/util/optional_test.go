@@ -1,11 +1,18 @@
 package util
 
 import (
+	"bytes"
+	"context"
 	"errors"
+	"fmt"
+	"io"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/dairyo/j2g/java/util/function/function"
+	"github.com/dairyo/j2g/java/util/function/supplier"
+	"github.com/google/go-cmp/cmp"
 )
 
 func checkNotEmpty[T any](t *testing.T, o *Optional[T]) {
@@ -26,6 +33,101 @@ func checkGet[T comparable](t *testing.T, o *Optional[T], want T) {
 	}
 }
 
+func checkReason(t *testing.T, err error, op Op, step int) *EmptyReason {
+	t.Helper()
+	var r *EmptyReason
+	if !errors.As(err, &r) {
+		t.Fatalf("error must be EmptyReason but %q.", err)
+	}
+	if r.Op != op || r.Step != step {
+		t.Fatalf("want=%s at step %d, got=%s at step %d", op, step, r.Op, r.Step)
+	}
+	return r
+}
+
+func TestConstructors(t *testing.T) {
+	var (
+		nilAny     any
+		nilWriter  io.Writer
+		typedNil   any       = (*bytes.Buffer)(nil)
+		typedNilW  io.Writer = (*bytes.Buffer)(nil)
+		nilPointer *int
+		nilSlice   []int
+		nilMap     map[int]int
+		nilFunc    func()
+		nilChan    chan int
+	)
+	tests := []struct {
+		name    string
+		of      func() *Optional[any]
+		present bool
+		err     error
+	}{
+		{"Of value", func() *Optional[any] { return Of[any](1) }, true, nil},
+		{"Of zero int", func() *Optional[any] { return Of[any](0) }, true, nil},
+		{"Of empty string", func() *Optional[any] { return Of[any]("") }, true, nil},
+		{"Of nil interface", func() *Optional[any] { return Of(nilAny) }, false, ErrNilValue},
+		{"Of typed nil in interface", func() *Optional[any] { return Of(typedNil) }, false, ErrNilValue},
+		{"Of nil pointer", func() *Optional[any] { return Of[any](nilPointer) }, false, ErrNilValue},
+		{"Of nil slice", func() *Optional[any] { return Of[any](nilSlice) }, false, ErrNilValue},
+		{"Of nil map", func() *Optional[any] { return Of[any](nilMap) }, false, ErrNilValue},
+		{"Of nil func", func() *Optional[any] { return Of[any](nilFunc) }, false, ErrNilValue},
+		{"Of nil chan", func() *Optional[any] { return Of[any](nilChan) }, false, ErrNilValue},
+		{"Of empty slice", func() *Optional[any] { return Of[any]([]int{}) }, true, nil},
+		{"OfNullable value", func() *Optional[any] { return OfNullable[any](1) }, true, nil},
+		{"OfNullable nil interface", func() *Optional[any] { return OfNullable(nilAny) }, false, ErrEmpty},
+		{"OfNullable typed nil in interface", func() *Optional[any] { return OfNullable(typedNil) }, false, ErrEmpty},
+		{"OfNullable nil pointer", func() *Optional[any] { return OfNullable[any](nilPointer) }, false, ErrEmpty},
+		{"OfPresent value", func() *Optional[any] { return OfPresent[any](1) }, true, nil},
+		{"OfPresent nil interface", func() *Optional[any] { return OfPresent(nilAny) }, true, nil},
+		{"OfPresent nil pointer", func() *Optional[any] { return OfPresent[any](nilPointer) }, true, nil},
+		{"Empty", func() *Optional[any] { return Empty[any]() }, false, ErrEmpty},
+		{"EmptyWithError", func() *Optional[any] { return EmptyWithError[any](io.EOF) }, false, io.EOF},
+		{"EmptyWithError nil", func() *Optional[any] { return EmptyWithError[any](nil) }, false, ErrEmpty},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := tt.of()
+			if o.IsPresent() != tt.present {
+				t.Fatalf("IsPresent: want=%t, got=%t", tt.present, o.IsPresent())
+			}
+			if got := o.Error(); got != tt.err {
+				t.Fatalf("Error: want=%v, got=%v", tt.err, got)
+			}
+		})
+	}
+
+	t.Run("interface type parameter", func(t *testing.T) {
+		if got := Of(nilWriter).Error(); got != ErrNilValue {
+			t.Errorf("want=%v, got=%v", ErrNilValue, got)
+		}
+		if got := OfNullable(typedNilW).Error(); got != ErrEmpty {
+			t.Errorf("want=%v, got=%v", ErrEmpty, got)
+		}
+		var w io.Writer = &bytes.Buffer{}
+		checkGet(t, Of(w), w)
+	})
+
+	t.Run("zero value", func(t *testing.T) {
+		var o Optional[int]
+		if o.IsPresent() {
+			t.Error("must be empty.")
+		}
+		if got := o.Error(); got != ErrEmpty {
+			t.Errorf("want=%v, got=%v", ErrEmpty, got)
+		}
+	})
+
+	t.Run("non nilable type parameter", func(t *testing.T) {
+		checkGet(t, Of(0), 0)
+		checkGet(t, OfNullable(""), "")
+		checkGet(t, Of(struct{}{}), struct{}{})
+		if Empty[int]().IsPresent() {
+			t.Error("must be empty.")
+		}
+	})
+}
+
 func TestMap(t *testing.T) {
 	t.Run("int to string", func(t *testing.T) {
 		i := NewOptional(int(1))
@@ -65,20 +167,21 @@ func TestMap(t *testing.T) {
 		i := NewOptional((*int)(nil))
 		o := Map(i, func(*int) (int, error) { return 1, nil })
 		got := o.Error()
-		if !errors.Is(got, ErrEmpty) {
+		if got != ErrEmpty {
 			t.Fatalf("want=%s, got=%s", ErrEmpty, got)
 		}
-		if got.Error() != "invalid optional is passed: empty optional" {
-			t.Fatalf("want=%s, got=%s", "invalid optional is passed: empty optional", got.Error())
-		}
 	})
 
 	t.Run("function returns error", func(t *testing.T) {
 		i := NewOptional("1")
 		o := Map(i, func(string) (int, error) { return 0, errors.New("foo") })
 		got := o.Error()
-		if got.Error() != "function returns error: foo" {
-			t.Fatalf("want=%s, got=%s", "function returns error: foo", got.Error())
+		r := checkReason(t, got, OpMap, 1)
+		if r.Cause.Error() != "function returns error: foo" {
+			t.Fatalf("want=%s, got=%s", "function returns error: foo", r.Cause.Error())
+		}
+		if got.Error() != "Map at step 1: function returns error: foo" {
+			t.Fatalf("want=%s, got=%s", "Map at step 1: function returns error: foo", got.Error())
 		}
 	})
 }
@@ -205,17 +308,14 @@ func TestFilter(t *testing.T) {
 	t.Run("match", func(t *testing.T) {
 		s := NewOptional("foo")
 		filtered := s.Filter(func(_ string) (bool, error) { return true, nil })
-		if s != filtered {
-			got, _ := filtered.Get()
-			t.Errorf("should match but not: %q, %q.", "foo", got)
-		}
+		checkGet(t, filtered, "foo")
 	})
 
 	t.Run("not match", func(t *testing.T) {
 		s := NewOptional("foo")
 		filtered := s.Filter(func(_ string) (bool, error) { return false, nil })
 		got := filtered.Error()
-		if got != ErrPredicateFailed {
+		if r := checkReason(t, got, OpFilter, 1); r.Cause != ErrPredicateFailed {
 			t.Errorf("should return ErrPredicateFailed but %q.", got)
 		}
 	})
@@ -224,7 +324,7 @@ func TestFilter(t *testing.T) {
 		s := NewOptional("foo")
 		filtered := s.Filter((func(_ string) (bool, error))(nil))
 		got := filtered.Error()
-		if got != ErrNilPredicate {
+		if r := checkReason(t, got, OpFilter, 1); r.Cause != ErrNilPredicate {
 			t.Errorf("should return ErrNilPredicate but %q.", got)
 		}
 	})
@@ -234,7 +334,7 @@ func TestFilter(t *testing.T) {
 		want := errors.New("bar")
 		filtered := s.Filter(func(_ string) (bool, error) { return false, want })
 		err := filtered.Error()
-		unwrap, ok := err.(interface{ Unwrap() []error })
+		unwrap, ok := checkReason(t, err, OpFilter, 1).Cause.(interface{ Unwrap() []error })
 		if !ok {
 			t.Error("error should wrap.")
 		}
@@ -253,8 +353,8 @@ func TestFilter(t *testing.T) {
 	t.Run("empty", func(t *testing.T) {
 		s := NewOptional[*string](nil)
 		filtered := s.Filter(func(_ *string) (bool, error) { return true, nil })
-		if s != filtered {
-			t.Errorf("filtered must be same %#v, %#v", s, filtered)
+		if got := filtered.Error(); got != s.Error() {
+			t.Errorf("filtered must have the same error %q, %q", s.Error(), got)
 		}
 	})
 }
@@ -265,17 +365,14 @@ func TestOr(t *testing.T) {
 		supplied := s.Or(func() (*Optional[string], error) {
 			return NewOptional("bar"), nil
 		})
-		if s != supplied {
-			got, _ := supplied.Get()
-			t.Errorf("should match but not: %q, %q.", "foo", got)
-		}
+		checkGet(t, supplied, "foo")
 	})
 
 	t.Run("has value and nil supplier", func(t *testing.T) {
 		s := NewOptional("foo")
 		supplied := s.Or((func() (*Optional[string], error))(nil))
 		want := supplied.Error()
-		if want != ErrNilSupplier {
+		if r := checkReason(t, want, OpOr, 1); r.Cause != ErrNilSupplier {
 			t.Errorf("want=%q, got=%q", ErrNilSupplier, want)
 		}
 	})
@@ -360,14 +457,94 @@ func TestOr(t *testing.T) {
 		want := errors.New("foo")
 		supplied := s.Or(func() (*Optional[*string], error) { return nil, want })
 		err := supplied.Error()
-		unwrap, ok := err.(interface{ Unwrap() []error })
+		r := checkReason(t, err, OpOr, 1)
+		if r.Root != ErrEmpty {
+			t.Errorf("want=%q, got=%q", ErrEmpty, r.Root)
+		}
+		unwrap, ok := r.Cause.(interface{ Unwrap() []error })
 		if !ok {
 			t.Error("error should wrap.")
 		}
 		errs := unwrap.Unwrap()
-		if len(errs) != 3 {
-			t.Errorf("error must be 3 but %d.", len(errs))
+		if len(errs) != 2 {
+			t.Errorf("error must be 2 but %d.", len(errs))
+		}
+		if !errors.Is(err, ErrEmpty) {
+			t.Errorf("error must contain %q but %q.", ErrEmpty, err)
+		}
+		if !errors.Is(err, ErrSupplierErr) {
+			t.Errorf("error must contain %q but %q.", ErrSupplierErr, err)
+		}
+		if !errors.Is(err, want) {
+			t.Errorf("error must contain %q but %q.", want, err)
+		}
+	})
+}
+
+func TestOrElse(t *testing.T) {
+	t.Run("has value", func(t *testing.T) {
+		s := NewOptional("foo")
+		if got := s.OrElse("bar"); got != "foo" {
+			t.Errorf("want=%q, got=%q.", "foo", got)
+		}
+	})
+
+	t.Run("not have value", func(t *testing.T) {
+		s := NewOptional[*string](nil)
+		str := "bar"
+		if got := s.OrElse(&str); got != &str {
+			t.Errorf("want=%v, got=%v.", &str, got)
+		}
+	})
+}
+
+func TestOrElseGet(t *testing.T) {
+	t.Run("has value", func(t *testing.T) {
+		s := NewOptional("foo")
+		got, err := s.OrElseGet(func() (string, error) { return "bar", nil })
+		if err != nil {
+			t.Errorf("should not return error but %q.", err)
+		}
+		if got != "foo" {
+			t.Errorf("want=%q, got=%q.", "foo", got)
+		}
+	})
+
+	t.Run("has value and nil supplier", func(t *testing.T) {
+		s := NewOptional("foo")
+		_, err := s.OrElseGet(nil)
+		if err != ErrNilSupplier {
+			t.Errorf("want=%q, got=%q", ErrNilSupplier, err)
+		}
+	})
+
+	t.Run("not have value", func(t *testing.T) {
+		s := NewOptional[*int](nil)
+		i := 1
+		got, err := s.OrElseGet(func() (*int, error) { return &i, nil })
+		if err != nil {
+			t.Errorf("should not return error but %q.", err)
+		}
+		if got != &i {
+			t.Errorf("want=%v, got=%v.", &i, got)
+		}
+	})
+
+	t.Run("not have value and nil supplier", func(t *testing.T) {
+		s := NewOptional[*int](nil)
+		_, err := s.OrElseGet(nil)
+		if !errors.Is(err, ErrEmpty) {
+			t.Errorf("error must contain %q but %q.", ErrEmpty, err)
+		}
+		if !errors.Is(err, ErrNilSupplier) {
+			t.Errorf("error must contain %q but %q.", ErrNilSupplier, err)
 		}
+	})
+
+	t.Run("not have value and supplier returns error", func(t *testing.T) {
+		s := NewOptional[*int](nil)
+		want := errors.New("foo")
+		_, err := s.OrElseGet(func() (*int, error) { return nil, want })
 		if !errors.Is(err, ErrEmpty) {
 			t.Errorf("error must contain %q but %q.", ErrEmpty, err)
 		}
@@ -379,3 +556,339 @@ func TestOr(t *testing.T) {
 		}
 	})
 }
+
+func TestOrElseErr(t *testing.T) {
+	want := errors.New("foo")
+	supply := func() (error, error) { return want, nil }
+
+	t.Run("has value", func(t *testing.T) {
+		s := NewOptional("foo")
+		got, err := s.OrElseErr(supply)
+		if err != nil {
+			t.Errorf("should not return error but %q.", err)
+		}
+		if got != "foo" {
+			t.Errorf("want=%q, got=%q.", "foo", got)
+		}
+	})
+
+	t.Run("has value and nil supplier", func(t *testing.T) {
+		s := NewOptional("foo")
+		_, err := s.OrElseErr(nil)
+		if err != ErrNilSupplier {
+			t.Errorf("want=%q, got=%q", ErrNilSupplier, err)
+		}
+	})
+
+	t.Run("not have value", func(t *testing.T) {
+		s := NewOptional[*int](nil)
+		_, err := s.OrElseErr(supply)
+		if err != want {
+			t.Errorf("want=%q, got=%q", want, err)
+		}
+	})
+
+	t.Run("not have value and supplier produces nil", func(t *testing.T) {
+		s := NewOptional[*int](nil)
+		_, err := s.OrElseErr(func() (error, error) { return nil, nil })
+		if err != ErrNoValue {
+			t.Errorf("want=%q, got=%q", ErrNoValue, err)
+		}
+	})
+
+	t.Run("not have value and supplier returns error", func(t *testing.T) {
+		s := NewOptional[*int](nil)
+		_, err := s.OrElseErr(func() (error, error) { return nil, want })
+		if !errors.Is(err, ErrSupplierErr) {
+			t.Errorf("error must contain %q but %q.", ErrSupplierErr, err)
+		}
+		if !errors.Is(err, want) {
+			t.Errorf("error must contain %q but %q.", want, err)
+		}
+	})
+}
+
+func TestEquals(t *testing.T) {
+	if !NewOptional(1).Equals(NewOptional(1)) {
+		t.Error("same values must be equal.")
+	}
+	if NewOptional(1).Equals(NewOptional(2)) {
+		t.Error("different values must not be equal.")
+	}
+	if !NewOptional([]int{1, 2}).Equals(NewOptional([]int{1, 2})) {
+		t.Error("same slices must be equal.")
+	}
+	if NewOptional(1).Equals(1) {
+		t.Error("Optional must not be equal to non Optional.")
+	}
+	if NewOptional(1).Equals(NewOptional(int64(1))) {
+		t.Error("Optionals of different types must not be equal.")
+	}
+	e1 := NewOptional[*int](nil)
+	e2 := NewOptional[*int](nil).Filter(func(*int) (bool, error) { return false, nil })
+	if !e1.Equals(e2) {
+		t.Error("empty Optionals must be equal.")
+	}
+	i := 1
+	if e1.Equals(NewOptional(&i)) || NewOptional(&i).Equals(e1) {
+		t.Error("empty and present Optionals must not be equal.")
+	}
+}
+
+func TestString(t *testing.T) {
+	if got := NewOptional(42).String(); got != "Optional[42]" {
+		t.Errorf("want=%q, got=%q", "Optional[42]", got)
+	}
+	if got := NewOptional[*int](nil).String(); got != "Optional.empty" {
+		t.Errorf("want=%q, got=%q", "Optional.empty", got)
+	}
+}
+
+func TestEmptyReason(t *testing.T) {
+	even := func(i int) (bool, error) { return i%2 == 0, nil }
+	inc := function.WrapNoErr(func(i int) int { return i + 1 })
+
+	t.Run("step of failing operation", func(t *testing.T) {
+		o := Map(Map(NewOptional(1), inc).Filter(even), inc).Filter(even)
+		r := checkReason(t, o.Error(), OpFilter, 4)
+		if r.Cause != ErrPredicateFailed || r.Root != ErrPredicateFailed {
+			t.Errorf("want=%q, got=%q", ErrPredicateFailed, r)
+		}
+		if got, want := o.Error().Error(), "Filter at step 4: Predicate returns false"; got != want {
+			t.Errorf("want=%q, got=%q", want, got)
+		}
+	})
+
+	t.Run("reason is kept through empty chain", func(t *testing.T) {
+		o := Map(NewOptional(1).Filter(even), inc)
+		o = FlatMap(o, func(i int) (*Optional[int], error) { return NewOptional(i), nil }).Filter(even)
+		checkReason(t, o.Error(), OpFilter, 1)
+	})
+
+	t.Run("Or keeps root", func(t *testing.T) {
+		want := errors.New("foo")
+		o := Map(NewOptional(1), inc).Filter(func(int) (bool, error) { return false, want })
+		o = Map(o, inc).Or(func() (*Optional[int], error) { return nil, errors.New("bar") })
+		r := checkReason(t, o.Error(), OpOr, 4)
+		if !errors.Is(r.Root, want) {
+			t.Errorf("root must contain %q but %q.", want, r.Root)
+		}
+		if !errors.Is(o.Error(), ErrSupplierErr) {
+			t.Errorf("error must contain %q but %q.", ErrSupplierErr, o.Error())
+		}
+	})
+
+	t.Run("Or recovers", func(t *testing.T) {
+		o := NewOptional(1).Filter(even).Or(func() (*Optional[int], error) { return NewOptional(2), nil })
+		o = o.Filter(func(int) (bool, error) { return false, nil })
+		checkReason(t, o.Error(), OpFilter, 3)
+	})
+
+	t.Run("FlatMap", func(t *testing.T) {
+		o := FlatMap(NewOptional(1), func(int) (*Optional[int], error) { return nil, nil })
+		if r := checkReason(t, o.Error(), OpFlatMap, 1); r.Cause != ErrEmpty {
+			t.Errorf("want=%q, got=%q", ErrEmpty, r.Cause)
+		}
+		o = FlatMap(NewOptional(1), func(int) (*Optional[int], error) { return nil, errors.New("foo") })
+		checkReason(t, o.Error(), OpFlatMap, 1)
+	})
+
+	t.Run("Map returns nil", func(t *testing.T) {
+		o := Map(NewOptional(1), func(int) (*int, error) { return nil, nil })
+		if r := checkReason(t, o.Error(), OpMap, 1); r.Cause != ErrEmpty {
+			t.Errorf("want=%q, got=%q", ErrEmpty, r.Cause)
+		}
+	})
+
+	t.Run("constructor", func(t *testing.T) {
+		var r *EmptyReason
+		if errors.As(Empty[int]().Error(), &r) {
+			t.Error("constructor must not return EmptyReason.")
+		}
+	})
+}
+
+func TestAll(t *testing.T) {
+	var got []int
+	for v := range NewOptional(1).All() {
+		got = append(got, v)
+	}
+	if len(got) != 1 || got[0] != 1 {
+		t.Errorf("want=[1], got=%v", got)
+	}
+	for v := range Empty[int]().All() {
+		t.Errorf("must not yield but %d.", v)
+	}
+}
+
+func TestFromSeq(t *testing.T) {
+	consumed := 0
+	seq := func(yield func(int) bool) {
+		for i := 1; i <= 3; i++ {
+			consumed++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	checkGet(t, FromSeq(seq), 1)
+	if consumed != 1 {
+		t.Errorf("want=1, got=%d", consumed)
+	}
+	checkGet(t, FromSeq(NewOptional("foo").All()), "foo")
+	if got := FromSeq(Empty[int]().All()).Error(); got != ErrEmpty {
+		t.Errorf("want=%q, got=%q", ErrEmpty, got)
+	}
+	if got := FromSeq[int](nil).Error(); got != ErrEmpty {
+		t.Errorf("want=%q, got=%q", ErrEmpty, got)
+	}
+	if got := FromSeq(NewOptional[*int](nil).All()).Error(); got != ErrEmpty {
+		t.Errorf("want=%q, got=%q", ErrEmpty, got)
+	}
+}
+
+func TestEqual(t *testing.T) {
+	even := func(i int) (bool, error) { return i%2 == 0, nil }
+	tests := []struct {
+		name string
+		a, b *Optional[int]
+		want bool
+	}{
+		{"same values", NewOptional(1), NewOptional(1), true},
+		{"different values", NewOptional(1), NewOptional(2), false},
+		{"present and empty", NewOptional(1), Empty[int](), false},
+		{"both empty", Empty[int](), Empty[int](), true},
+		{"same reasons", NewOptional(1).Filter(even), NewOptional(3).Filter(even), true},
+		{"different steps", NewOptional(1).Filter(even), Map(NewOptional(1), function.WrapNoErr(func(i int) int { return i })).Filter(even), false},
+		{"reason and sentinel", NewOptional(1).Filter(even), Empty[int](), false},
+		{"nil", nil, nil, true},
+		{"nil and empty", nil, Empty[int](), false},
+		{"lazy", Lazy(supplier.WrapNoErr(func() int { return 1 })), NewOptional(1), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Equal(tt.b); got != tt.want {
+				t.Errorf("want=%t, got=%t", tt.want, got)
+			}
+			if got := tt.b.Equal(tt.a); got != tt.want {
+				t.Errorf("Equal must be symmetric. want=%t, got=%t", tt.want, got)
+			}
+		})
+	}
+
+	t.Run("joined reasons", func(t *testing.T) {
+		if !Zip2(Empty[int](), Empty[int]()).Equal(Zip2(Empty[int](), Empty[int]())) {
+			t.Error("Zip2 of empty Optionals must be equal.")
+		}
+		if Zip2(Empty[int](), Empty[int]()).Equal(Zip2(Empty[int](), NewOptional(1).Filter(even))) {
+			t.Error("Zip2 with different reasons must not be equal.")
+		}
+		e := errors.New("error")
+		fail := func(int) (bool, error) { return false, e }
+		if !NewOptional(1).Filter(fail).Equal(NewOptional(2).Filter(fail)) {
+			t.Error("Filter with the same predicate error must be equal.")
+		}
+		fail2 := func(int) (bool, error) { return false, errors.New("other") }
+		if NewOptional(1).Filter(fail).Equal(NewOptional(1).Filter(fail2)) {
+			t.Error("Filter with different predicate errors must not be equal.")
+		}
+		var a, b Optional[int]
+		a.Scan("foo")
+		b.Scan("foo")
+		if !a.Equal(&b) {
+			t.Errorf("Scan with the same mismatch must be equal but %v and %v.", a.Error(), b.Error())
+		}
+		b.Scan("bar")
+		if a.Equal(&b) {
+			t.Error("Scan with different mismatches must not be equal.")
+		}
+		if EmptyWithError[int](errors.New("not found")).Equal(EmptyWithError[int](errors.New("not found"))) {
+			t.Error("distinct errors with the same message must not be equal.")
+		}
+		wrap := func() error { return fmt.Errorf("wrap: %w", e) }
+		if !EmptyWithError[int](wrap()).Equal(EmptyWithError[int](wrap())) {
+			t.Error("errors wrapping the same error must be equal.")
+		}
+		if diff := cmp.Diff(Zip2(Empty[int](), Empty[int]()), Zip2(Empty[int](), Empty[int]())); diff != "" {
+			t.Errorf("must be equal but diff is %s", diff)
+		}
+	})
+
+	t.Run("go-cmp", func(t *testing.T) {
+		type s struct{ V *Optional[[]int] }
+		if diff := cmp.Diff(s{NewOptional([]int{1})}, s{NewOptional([]int{1})}); diff != "" {
+			t.Errorf("must be equal but diff is %s", diff)
+		}
+		if cmp.Equal(s{NewOptional([]int{1})}, s{NewOptional([]int{2})}) {
+			t.Error("must not be equal.")
+		}
+	})
+
+	t.Run("Equal method of value", func(t *testing.T) {
+		a := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		if !NewOptional(a).Equal(NewOptional(a.In(time.FixedZone("X", 3600)))) {
+			t.Error("Equal method of T must be used.")
+		}
+	})
+}
+
+func TestNilOptional(t *testing.T) {
+	var o *Optional[int]
+	if err := o.Error(); err != ErrEmpty {
+		t.Errorf("want=%q, got=%q", ErrEmpty, err)
+	}
+	if o.IsPresent() || !o.IsEmpty() {
+		t.Error("must be empty.")
+	}
+	if _, err := o.Get(); err != ErrNoValue {
+		t.Errorf("want=%q, got=%q", ErrNoValue, err)
+	}
+	if got := o.OrElse(1); got != 1 {
+		t.Errorf("want=%d, got=%d", 1, got)
+	}
+	if got, err := o.OrElseGet(func() (int, error) { return 1, nil }); got != 1 || err != nil {
+		t.Errorf("want=(1, nil), got=(%d, %v)", got, err)
+	}
+	e := errors.New("error")
+	if _, err := o.OrElseErr(func() (error, error) { return e, nil }); err != e {
+		t.Errorf("want=%q, got=%q", e, err)
+	}
+	if err := o.IfPresent(func(int) error { return nil }); err != ErrNoValue {
+		t.Errorf("want=%q, got=%q", ErrNoValue, err)
+	}
+	r := newRunnableCalled(t, nil)
+	if err := o.IfPresentOrElse(nil, r.run); err != nil {
+		t.Errorf("must not return error but %q.", err)
+	}
+	r.checkCalled()
+
+	positive := func(i int) (bool, error) { return i > 0, nil }
+	supply := func() (*Optional[int], error) { return NewOptional(1), nil }
+	checkEmpty := func(got *Optional[int]) {
+		t.Helper()
+		if err := got.Error(); err != ErrEmpty {
+			t.Errorf("want=%q, got=%q", ErrEmpty, err)
+		}
+	}
+	checkEmpty(o.Filter(positive))
+	checkEmpty(o.WithRecover())
+	checkEmpty(o.FilterCtx(context.Background(), func(_ context.Context, i int) (bool, error) { return positive(i) }))
+	checkGet(t, o.Or(supply), 1)
+	checkGet(t, o.OrCtx(context.Background(), func(context.Context) (*Optional[int], error) { return supply() }), 1)
+	for v := range o.All() {
+		t.Errorf("must yield nothing but %d.", v)
+	}
+
+	if !o.Equals(Empty[int]()) || o.Equals(NewOptional(1)) {
+		t.Error("must equal only an empty Optional.")
+	}
+	if !o.Equal(nil) || o.Equal(Empty[int]()) {
+		t.Error("must be Equal only to nil.")
+	}
+	if got := o.String(); got != "Optional.empty" {
+		t.Errorf("want=%q, got=%q", "Optional.empty", got)
+	}
+	if got := fmt.Sprintf("%v", o); got != "<nil>" {
+		t.Errorf("want=%q, got=%q", "<nil>", got)
+	}
+}
@@ -0,0 +1,127 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/dairyo/j2g/java/util/function"
+)
+
+// Pair is a pair of values combined by [Zip2].
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Triple is a triple of values combined by [Zip3].
+type Triple[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// Zip2 returns an [Optional] instance holding the [Pair] of the
+// values of a and b if both a and b are present. Otherwise Zip2
+// returns empty [Optional] whose [Optional.Error] joins the errors of
+// all empty inputs with [errors.Join]. A nil input is treated as an
+// empty input whose error is [ErrMapNilOptinal].
+func Zip2[A, B any](a *Optional[A], b *Optional[B]) *Optional[Pair[A, B]] {
+	return ZipWith(a, b, func(va A, vb B) (Pair[A, B], error) {
+		return Pair[A, B]{va, vb}, nil
+	})
+}
+
+// Zip3 returns an [Optional] instance holding the [Triple] of the
+// values of a, b and c if all of them are present. Otherwise Zip3
+// returns empty [Optional] in the same way as [Zip2].
+func Zip3[A, B, C any](a *Optional[A], b *Optional[B], c *Optional[C]) *Optional[Triple[A, B, C]] {
+	a, b, c = loadArg(a), loadArg(b), loadArg(c)
+	o := &Optional[Triple[A, B, C]]{
+		step:    nextStep(a.stepOrZero(), b.stepOrZero(), c.stepOrZero()),
+		recover: a.recovers() || b.recovers() || c.recovers(),
+	}
+	if err := errors.Join(emptyErr(a), emptyErr(b), emptyErr(c)); err != nil {
+		o.err = err
+		return o
+	}
+	o.val = Triple[A, B, C]{a.val, b.val, c.val}
+	o.present = true
+	return o
+}
+
+// ZipWith returns an [Optional] instance holding the result of
+// applying f to the values of a and b if both a and b are present.
+// If a or b is empty, ZipWith returns empty [Optional] in the same
+// way as [Zip2]. If f is nil, returns error or returns nil, ZipWith
+// returns empty [Optional] whose [Optional.Error] returns an
+// [*EmptyReason] whose Op is [OpZip].
+func ZipWith[A, B, C any](a *Optional[A], b *Optional[B], f func(A, B) (C, error)) *Optional[C] {
+	a, b = loadArg(a), loadArg(b)
+	o := &Optional[C]{
+		step:    nextStep(a.stepOrZero(), b.stepOrZero()),
+		recover: a.recovers() || b.recovers(),
+	}
+	if err := errors.Join(emptyErr(a), emptyErr(b)); err != nil {
+		o.err = err
+		return o
+	}
+	if f == nil {
+		o.fail(OpZip, ErrMapNilFunction, nil)
+		return o
+	}
+	ret, err := callZip(o.recover, f, a.val, b.val)
+	if err != nil {
+		o.fail(OpZip, fmt.Errorf("function returns error: %w", err), nil)
+		return o
+	}
+	if isNil(ret) {
+		o.fail(OpZip, ErrEmpty, nil)
+		return o
+	}
+	o.val = ret
+	o.present = true
+	return o
+}
+
+func callZip[A, B, C any](rec bool, f func(A, B) (C, error), a A, b B) (ret C, err error) {
+	if rec {
+		defer function.RecoverAsError(&err)
+	}
+	return f(a, b)
+}
+
+// loadArg resolves o unless o is nil, so that a nil argument is
+// reported as [ErrMapNilOptinal] by emptyErr.
+func loadArg[T any](o *Optional[T]) *Optional[T] {
+	if o == nil {
+		return nil
+	}
+	return o.load()
+}
+
+// emptyErr returns the error of o if o is empty. Otherwise returns
+// nil.
+func emptyErr[T any](o *Optional[T]) error {
+	if o == nil {
+		return ErrMapNilOptinal
+	}
+	return o.Error()
+}
+
+func (o *Optional[T]) stepOrZero() int {
+	if o == nil {
+		return 0
+	}
+	return o.step
+}
+
+func (o *Optional[T]) recovers() bool {
+	return o != nil && o.recover
+}
+
+// nextStep returns the step of an operation which combines
+// Optionals at steps.
+func nextStep(steps ...int) int {
+	return slices.Max(steps) + 1
+}
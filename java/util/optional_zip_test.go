@@ -0,0 +1,76 @@
+package util
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestZip2(t *testing.T) {
+	checkGet(t, Zip2(NewOptional(1), NewOptional("a")), Pair[int, string]{1, "a"})
+
+	even := func(i int) (bool, error) { return i%2 == 0, nil }
+	a := NewOptional(1).Filter(even)
+	b := Empty[string]()
+	o := Zip2(a, b)
+	err := o.Error()
+	if !errors.Is(err, ErrPredicateFailed) || !errors.Is(err, ErrEmpty) {
+		t.Errorf("error must contain %q and %q but %q.", ErrPredicateFailed, ErrEmpty, err)
+	}
+	checkReason(t, err, OpFilter, 1)
+
+	if err := Zip2(NewOptional(1), b).Error(); err.Error() != ErrEmpty.Error() {
+		t.Errorf("want=%q, got=%q", ErrEmpty, err)
+	}
+	if err := Zip2[int, string](nil, nil).Error(); !errors.Is(err, ErrMapNilOptinal) {
+		t.Errorf("error must contain %q but %q.", ErrMapNilOptinal, err)
+	}
+}
+
+func TestZip3(t *testing.T) {
+	checkGet(t, Zip3(NewOptional(1), NewOptional("a"), NewOptional(true)), Triple[int, string, bool]{1, "a", true})
+
+	e1 := errors.New("foo")
+	e2 := errors.New("bar")
+	a := Map(NewOptional(1), func(int) (int, error) { return 0, e1 })
+	c := Map(NewOptional(1), func(int) (bool, error) { return false, e2 })
+	err := Zip3(a, NewOptional("a"), c).Error()
+	if !errors.Is(err, e1) || !errors.Is(err, e2) {
+		t.Errorf("error must contain %q and %q but %q.", e1, e2, err)
+	}
+	if errs := err.(interface{ Unwrap() []error }).Unwrap(); len(errs) != 2 {
+		t.Errorf("error must be 2 but %d.", len(errs))
+	}
+}
+
+func TestZipWith(t *testing.T) {
+	concat := func(i int, s string) (string, error) { return strconv.Itoa(i) + s, nil }
+	checkGet(t, ZipWith(NewOptional(1), NewOptional("a"), concat), "1a")
+
+	o := ZipWith(Map(NewOptional(1), func(i int) (int, error) { return i, nil }), NewOptional("a"), concat)
+	o = o.Filter(func(string) (bool, error) { return false, nil })
+	checkReason(t, o.Error(), OpFilter, 3)
+
+	want := errors.New("foo")
+	o = ZipWith(NewOptional(1), NewOptional("a"), func(int, string) (string, error) { return "", want })
+	if r := checkReason(t, o.Error(), OpZip, 1); !errors.Is(r.Cause, want) {
+		t.Errorf("error must contain %q but %q.", want, r.Cause)
+	}
+
+	o = ZipWith[int, string, string](NewOptional(1), NewOptional("a"), nil)
+	if r := checkReason(t, o.Error(), OpZip, 1); r.Cause != ErrMapNilFunction {
+		t.Errorf("want=%q, got=%q", ErrMapNilFunction, r.Cause)
+	}
+
+	called := false
+	o = ZipWith(Empty[int](), NewOptional("a"), func(int, string) (string, error) {
+		called = true
+		return "", nil
+	})
+	if called {
+		t.Error("should not be called.")
+	}
+	if !errors.Is(o.Error(), ErrEmpty) {
+		t.Errorf("error must contain %q but %q.", ErrEmpty, o.Error())
+	}
+}
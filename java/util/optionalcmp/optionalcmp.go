@@ -0,0 +1,27 @@
+// Package optionalcmp provides options of go-cmp for [util.Optional].
+package optionalcmp
+
+import (
+	"github.com/dairyo/j2g/java/util"
+	"github.com/google/go-cmp/cmp"
+)
+
+// Compare returns a [cmp.Option] which compares *util.Optional[T]
+// and util.Optional[T]. Present values are compared with [cmp.Equal]
+// with opts, so that a custom comparison of the values can be used.
+// Nil and empty Optionals are compared in the same way as
+// [util.Optional.Equal].
+func Compare[T any](opts ...cmp.Option) cmp.Option {
+	eq := func(a, b *util.Optional[T]) bool {
+		if a == nil || b == nil || a.IsEmpty() || b.IsEmpty() {
+			return a.Equal(b)
+		}
+		va, _ := a.Get()
+		vb, _ := b.Get()
+		return cmp.Equal(va, vb, opts...)
+	}
+	return cmp.Options{
+		cmp.Comparer(eq),
+		cmp.Comparer(func(a, b util.Optional[T]) bool { return eq(&a, &b) }),
+	}
+}
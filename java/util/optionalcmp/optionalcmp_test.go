@@ -0,0 +1,64 @@
+package optionalcmp
+
+import (
+	"testing"
+
+	"github.com/dairyo/j2g/java/util"
+	"github.com/google/go-cmp/cmp"
+)
+
+type record struct {
+	Name  string
+	Score *util.Optional[float64]
+	Tag   util.Optional[string]
+}
+
+func TestCompare(t *testing.T) {
+	approx := cmp.Comparer(func(a, b float64) bool { return a-b < 0.01 && b-a < 0.01 })
+	opt := Compare[float64](approx)
+
+	if !cmp.Equal(util.OfNullable(1.0), util.OfNullable(1.001), opt) {
+		t.Error("values must be compared with the given options.")
+	}
+	if cmp.Equal(util.OfNullable(1.0), util.OfNullable(1.1), opt) {
+		t.Error("different values must not be equal.")
+	}
+	if cmp.Equal(util.OfNullable(1.0), util.Empty[float64](), opt) {
+		t.Error("present and empty Optionals must not be equal.")
+	}
+	if !cmp.Equal(util.Empty[float64](), util.Empty[float64](), opt) {
+		t.Error("empty Optionals must be equal.")
+	}
+	var n *util.Optional[float64]
+	if !cmp.Equal(n, n, opt) {
+		t.Error("nil Optionals must be equal.")
+	}
+
+	x := record{Name: "a", Score: util.OfNullable(1.0), Tag: *util.OfNullable("x")}
+	y := record{Name: "a", Score: util.OfNullable(1.001), Tag: *util.OfNullable("y")}
+	if cmp.Equal(x, y, opt, Compare[string]()) {
+		t.Error("Optionals in fields must be compared.")
+	}
+	y.Tag = x.Tag
+	if diff := cmp.Diff(x, y, opt, Compare[string]()); diff != "" {
+		t.Errorf("must be equal but diff is %s", diff)
+	}
+}
+
+func TestCompareValueField(t *testing.T) {
+	type s struct{ V util.Optional[int] }
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("go-cmp must panic for an Optional value without Compare.")
+			}
+		}()
+		cmp.Equal(s{*util.OfNullable(1)}, s{*util.OfNullable(1)})
+	}()
+	if diff := cmp.Diff(s{*util.OfNullable(1)}, s{*util.OfNullable(1)}, Compare[int]()); diff != "" {
+		t.Errorf("must be equal but diff is %s", diff)
+	}
+	if cmp.Equal(s{*util.OfNullable(1)}, s{*util.Empty[int]()}, Compare[int]()) {
+		t.Error("must not be equal.")
+	}
+}
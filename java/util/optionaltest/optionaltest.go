@@ -0,0 +1,64 @@
+// Package optionaltest provides assertion helpers for [util.Optional]
+// in tests. The helpers stop the test with [testing.TB.Fatalf] if
+// the assertion fails.
+package optionaltest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dairyo/j2g/java/util"
+	"github.com/google/go-cmp/cmp"
+)
+
+// RequirePresent checks that o has a value equal to want. The values
+// are compared with [cmp.Diff] with opts.
+func RequirePresent[T any](t testing.TB, o *util.Optional[T], want T, opts ...cmp.Option) {
+	t.Helper()
+	if o == nil {
+		t.Fatalf("Optional must have %v but nil.", want)
+	}
+	got, err := o.Get()
+	if err != nil {
+		t.Fatalf("Optional must have %v but empty: %v", want, o.Error())
+	}
+	if diff := cmp.Diff(want, got, opts...); diff != "" {
+		t.Fatalf("value mismatch (-want +got):\n%s", diff)
+	}
+}
+
+// RequireEmpty checks that o is empty.
+func RequireEmpty[T any](t testing.TB, o *util.Optional[T]) {
+	t.Helper()
+	if o == nil {
+		t.Fatal("Optional must be empty but nil.")
+	}
+	if o.IsPresent() {
+		t.Fatalf("Optional must be empty but %v.", o)
+	}
+}
+
+// RequireEmptyWith checks that o is empty and [util.Optional.Error]
+// matches target with [errors.Is].
+func RequireEmptyWith[T any](t testing.TB, o *util.Optional[T], target error) {
+	t.Helper()
+	RequireEmpty(t, o)
+	if err := o.Error(); !errors.Is(err, target) {
+		t.Fatalf("error must contain %q but %q.", target, err)
+	}
+}
+
+// RequireEmptyReason checks that o is empty because op at step made
+// it empty, and returns the [*util.EmptyReason].
+func RequireEmptyReason[T any](t testing.TB, o *util.Optional[T], op util.Op, step int) *util.EmptyReason {
+	t.Helper()
+	RequireEmpty(t, o)
+	var r *util.EmptyReason
+	if !errors.As(o.Error(), &r) {
+		t.Fatalf("error must be EmptyReason but %q.", o.Error())
+	}
+	if r.Op != op || r.Step != step {
+		t.Fatalf("want=%s at step %d, got=%s at step %d", op, step, r.Op, r.Step)
+	}
+	return r
+}
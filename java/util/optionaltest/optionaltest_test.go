@@ -0,0 +1,76 @@
+package optionaltest
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"testing"
+
+	"github.com/dairyo/j2g/java/util"
+)
+
+// fakeTB records whether a helper fails.
+type fakeTB struct {
+	testing.TB
+	failed bool
+	msg    string
+}
+
+func (f *fakeTB) Helper() {}
+
+func (f *fakeTB) Fatal(args ...any) {
+	f.Fatalf("%s", fmt.Sprint(args...))
+}
+
+func (f *fakeTB) Fatalf(format string, args ...any) {
+	f.failed = true
+	f.msg = fmt.Sprintf(format, args...)
+	runtime.Goexit()
+}
+
+// run runs h with a fakeTB and returns it after h finishes.
+func run(h func(testing.TB)) *fakeTB {
+	f := &fakeTB{}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		h(f)
+	}()
+	<-done
+	return f
+}
+
+func checkFail(t *testing.T, wantFail bool, h func(testing.TB)) {
+	t.Helper()
+	f := run(h)
+	if f.failed != wantFail {
+		t.Errorf("want=%t, got=%t: %s", wantFail, f.failed, f.msg)
+	}
+}
+
+func TestRequire(t *testing.T) {
+	even := func(i int) (bool, error) { return i%2 == 0, nil }
+	present := util.OfNullable(1)
+	empty := present.Filter(even)
+
+	checkFail(t, false, func(t testing.TB) { RequirePresent(t, present, 1) })
+	checkFail(t, true, func(t testing.TB) { RequirePresent(t, present, 2) })
+	checkFail(t, true, func(t testing.TB) { RequirePresent(t, empty, 1) })
+	checkFail(t, true, func(t testing.TB) { RequirePresent(t, nil, 1) })
+
+	checkFail(t, false, func(t testing.TB) { RequireEmpty(t, empty) })
+	checkFail(t, true, func(t testing.TB) { RequireEmpty(t, present) })
+
+	checkFail(t, false, func(t testing.TB) { RequireEmptyWith(t, empty, util.ErrPredicateFailed) })
+	checkFail(t, true, func(t testing.TB) { RequireEmptyWith(t, empty, util.ErrNilPredicate) })
+	checkFail(t, true, func(t testing.TB) { RequireEmptyWith(t, present, util.ErrPredicateFailed) })
+
+	checkFail(t, false, func(t testing.TB) {
+		if r := RequireEmptyReason(t, empty, util.OpFilter, 1); !errors.Is(r.Cause, util.ErrPredicateFailed) {
+			t.Fatalf("want=%q, got=%q", util.ErrPredicateFailed, r.Cause)
+		}
+	})
+	checkFail(t, true, func(t testing.TB) { RequireEmptyReason(t, empty, util.OpMap, 1) })
+	checkFail(t, true, func(t testing.TB) { RequireEmptyReason(t, empty, util.OpFilter, 2) })
+	checkFail(t, true, func(t testing.TB) { RequireEmptyReason(t, util.Empty[int](), util.OpFilter, 1) })
+}
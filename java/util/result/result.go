@@ -0,0 +1,242 @@
+// Package result provides [Result], which holds either a value or an
+// error. Result is the counterpart of a pair of (T, error) and can be
+// converted to and from [util.Optional] whose empty reason is the
+// error.
+//
+// The conversions are lossless. Unlike [util.Of], [Result.ToOptional]
+// of an Ok Result holding nil returns a present Optional holding nil
+// by [util.OfPresent], so that converting it back with [FromOptional]
+// returns the original Ok Result.
+package result
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/dairyo/j2g/java/util"
+	ufunction "github.com/dairyo/j2g/java/util/function"
+	"github.com/dairyo/j2g/java/util/function/consumer"
+	"github.com/dairyo/j2g/java/util/function/function"
+	"github.com/dairyo/j2g/java/util/function/supplier"
+)
+
+var (
+	ErrNilError  = errors.New("Err is called with nil")
+	ErrNilResult = errors.New("Result is nil")
+)
+
+// Result holds either a value or an error.
+type Result[T any] struct {
+	val T
+	err error
+}
+
+// Ok returns a [Result] holding value v. Unlike [util.Of], v may be
+// nil.
+func Ok[T any](v T) *Result[T] {
+	return &Result[T]{val: v}
+}
+
+// Err returns a [Result] holding error err. If err is nil, Err
+// returns a Result holding [ErrNilError].
+func Err[T any](err error) *Result[T] {
+	if err == nil {
+		err = ErrNilError
+	}
+	return &Result[T]{err: err}
+}
+
+// From returns a [Result] holding v if err is nil. Otherwise From
+// returns a Result holding err. From converts a pair of (T, error)
+// returned by a function to a Result.
+func From[T any](v T, err error) *Result[T] {
+	if err != nil {
+		return Err[T](err)
+	}
+	return Ok(v)
+}
+
+// Try returns a [Result] holding the value or the error produced by
+// [supplier.Supplier] s. If s is nil, Try returns a Result holding
+// [ufunction.ErrNilFunctional].
+func Try[T any](s supplier.Supplier[T]) *Result[T] {
+	if s == nil {
+		return Err[T](ufunction.ErrNilFunctional)
+	}
+	return From(s())
+}
+
+// FromOptional returns a [Result] holding the value of o if o is
+// present. Otherwise FromOptional returns a Result holding
+// [util.Optional.Error] of o. If o is nil, the Result holds
+// [util.ErrEmpty].
+func FromOptional[T any](o *util.Optional[T]) *Result[T] {
+	if o == nil {
+		return Err[T](util.ErrEmpty)
+	}
+	v, err := o.Get()
+	if err != nil {
+		return Err[T](o.Error())
+	}
+	return Ok(v)
+}
+
+// ToOptional returns an [util.Optional] holding the value of this
+// Result by [util.OfPresent], so the Optional is present even if the
+// value is nil. If this Result holds an error, ToOptional returns an
+// empty Optional whose [util.Optional.Error] returns the error.
+// Converting the Optional back with [FromOptional] returns an
+// equivalent Result.
+func (r *Result[T]) ToOptional() *util.Optional[T] {
+	if r.err != nil {
+		return util.EmptyWithError[T](r.err)
+	}
+	return util.OfPresent(r.val)
+}
+
+// IsOk returns true if this Result holds a value.
+func (r *Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr returns true if this Result holds an error.
+func (r *Result[T]) IsErr() bool {
+	return r.err != nil
+}
+
+// Unwrap returns the value and the error of this Result. One of them
+// is always the zero value.
+func (r *Result[T]) Unwrap() (T, error) {
+	return r.val, r.err
+}
+
+// Error returns the error of this Result. If this Result holds a
+// value, Error returns nil.
+func (r *Result[T]) Error() error {
+	return r.err
+}
+
+// OrElse returns the value of this Result if it holds a value.
+// Otherwise returns other.
+func (r *Result[T]) OrElse(other T) T {
+	if r.err != nil {
+		return other
+	}
+	return r.val
+}
+
+// OrElseGet returns the value of this Result if it holds a value.
+// Otherwise returns the result of [supplier.Supplier] s. If s is nil,
+// the error of this Result is joined with
+// [ufunction.ErrNilFunctional] and returned.
+func (r *Result[T]) OrElseGet(s supplier.Supplier[T]) (T, error) {
+	if r.err == nil {
+		return r.val, nil
+	}
+	if s == nil {
+		var zero T
+		return zero, errors.Join(ufunction.ErrNilFunctional, r.err)
+	}
+	return s()
+}
+
+// IfOk executes [consumer.Consumer] c if this Result holds a value
+// and returns the error returned by c. Otherwise returns the error of
+// this Result. If c is nil, [ufunction.ErrNilFunctional] is returned.
+func (r *Result[T]) IfOk(c consumer.Consumer[T]) error {
+	if r.err != nil {
+		return r.err
+	}
+	if c == nil {
+		return ufunction.ErrNilFunctional
+	}
+	return c(r.val)
+}
+
+// MapErr returns a new [Result] holding the error produced by
+// applying f to the error of this Result. If this Result holds a
+// value, it is returned as is. If f returns error, the returned
+// Result holds the error. If f is nil or produces nil, the returned
+// Result holds [ufunction.ErrNilFunctional] or [ErrNilError] joined
+// with the original error.
+func (r *Result[T]) MapErr(f function.Function[error, error]) *Result[T] {
+	if r.err == nil {
+		return r
+	}
+	if f == nil {
+		return Err[T](errors.Join(ufunction.ErrNilFunctional, r.err))
+	}
+	ret, err := f(r.err)
+	if err != nil {
+		return Err[T](err)
+	}
+	if ret == nil {
+		return Err[T](errors.Join(ErrNilError, r.err))
+	}
+	return Err[T](ret)
+}
+
+// Recover returns a new [Result] holding the value produced by
+// applying f to the error of this Result. If this Result holds a
+// value, it is returned as is. If f returns error, the returned
+// Result holds the error. If f is nil, the returned Result holds
+// [ufunction.ErrNilFunctional] joined with the original error.
+func (r *Result[T]) Recover(f function.Function[error, T]) *Result[T] {
+	if r.err == nil {
+		return r
+	}
+	if f == nil {
+		return Err[T](errors.Join(ufunction.ErrNilFunctional, r.err))
+	}
+	return From(f(r.err))
+}
+
+// String returns "Ok[v]" if this Result holds value v. Otherwise
+// returns "Err[e]" where e is the error.
+func (r *Result[T]) String() string {
+	if r.err != nil {
+		return fmt.Sprintf("Err[%v]", r.err)
+	}
+	return fmt.Sprintf("Ok[%v]", r.val)
+}
+
+// Map returns a new [Result] holding the value produced by applying
+// f to the value of r. If r holds an error, the returned Result holds
+// the same error. If f returns error, the returned Result holds the
+// error. If r is nil or f is nil, the returned Result holds
+// [ErrNilResult] or [ufunction.ErrNilFunctional].
+func Map[T, U any](r *Result[T], f function.Function[T, U]) *Result[U] {
+	if r == nil {
+		return Err[U](ErrNilResult)
+	}
+	if r.err != nil {
+		return Err[U](r.err)
+	}
+	if f == nil {
+		return Err[U](ufunction.ErrNilFunctional)
+	}
+	return From(f(r.val))
+}
+
+// FlatMap returns the [Result] produced by applying f to the value
+// of r. Errors are handled in the same way as [Map]. If f produces
+// nil, the returned Result holds [ErrNilResult].
+func FlatMap[T, U any](r *Result[T], f function.Function[T, *Result[U]]) *Result[U] {
+	if r == nil {
+		return Err[U](ErrNilResult)
+	}
+	if r.err != nil {
+		return Err[U](r.err)
+	}
+	if f == nil {
+		return Err[U](ufunction.ErrNilFunctional)
+	}
+	ret, err := f(r.val)
+	if err != nil {
+		return Err[U](err)
+	}
+	if ret == nil {
+		return Err[U](ErrNilResult)
+	}
+	return ret
+}
@@ -0,0 +1,146 @@
+package result
+
+import (
+	"errors"
+	"io"
+	"strconv"
+	"testing"
+
+	"github.com/dairyo/j2g/java/util"
+	ufunction "github.com/dairyo/j2g/java/util/function"
+	"github.com/dairyo/j2g/java/util/function/function"
+)
+
+func checkOk[T comparable](t *testing.T, r *Result[T], want T) {
+	t.Helper()
+	got, err := r.Unwrap()
+	if err != nil {
+		t.Fatalf("must not hold error but %q.", err)
+	}
+	if !r.IsOk() || r.IsErr() {
+		t.Error("must be Ok.")
+	}
+	if got != want {
+		t.Errorf("want=%v, got=%v", want, got)
+	}
+}
+
+func checkErr[T any](t *testing.T, r *Result[T], want error) {
+	t.Helper()
+	if r.IsOk() || !r.IsErr() {
+		t.Fatal("must be Err.")
+	}
+	if _, err := r.Unwrap(); !errors.Is(err, want) {
+		t.Errorf("error must contain %q but %q.", want, err)
+	}
+}
+
+func TestConstructors(t *testing.T) {
+	checkOk(t, Ok(1), 1)
+	checkOk(t, Ok[*int](nil), nil)
+	checkErr(t, Err[int](io.EOF), io.EOF)
+	checkErr(t, Err[int](nil), ErrNilError)
+	checkOk(t, From(strconv.Atoi("1")), 1)
+	checkErr(t, From(strconv.Atoi("x")), strconv.ErrSyntax)
+	checkOk(t, Try(func() (int, error) { return 1, nil }), 1)
+	checkErr(t, Try(func() (int, error) { return 0, io.EOF }), io.EOF)
+	checkErr(t, Try[int](nil), ufunction.ErrNilFunctional)
+}
+
+func TestMap(t *testing.T) {
+	atoi := function.Function[string, int](strconv.Atoi)
+	checkOk(t, Map(Ok("1"), atoi), 1)
+	checkErr(t, Map(Ok("x"), atoi), strconv.ErrSyntax)
+	checkErr(t, Map(Err[string](io.EOF), atoi), io.EOF)
+	checkErr(t, Map(Ok("1"), function.Function[string, int](nil)), ufunction.ErrNilFunctional)
+	checkErr(t, Map((*Result[string])(nil), atoi), ErrNilResult)
+
+	parse := func(s string) (*Result[int], error) { return From(strconv.Atoi(s)), nil }
+	checkOk(t, FlatMap(Ok("1"), parse), 1)
+	checkErr(t, FlatMap(Ok("x"), parse), strconv.ErrSyntax)
+	checkErr(t, FlatMap(Err[string](io.EOF), parse), io.EOF)
+	checkErr(t, FlatMap(Ok("1"), func(string) (*Result[int], error) { return nil, io.EOF }), io.EOF)
+	checkErr(t, FlatMap(Ok("1"), func(string) (*Result[int], error) { return nil, nil }), ErrNilResult)
+}
+
+func TestMapErr(t *testing.T) {
+	wrap := func(err error) (error, error) { return errors.Join(io.ErrUnexpectedEOF, err), nil }
+	r := Err[int](io.EOF).MapErr(wrap)
+	checkErr(t, r, io.EOF)
+	checkErr(t, r, io.ErrUnexpectedEOF)
+	checkOk(t, Ok(1).MapErr(wrap), 1)
+	checkErr(t, Err[int](io.EOF).MapErr(func(error) (error, error) { return nil, io.ErrClosedPipe }), io.ErrClosedPipe)
+	checkErr(t, Err[int](io.EOF).MapErr(func(error) (error, error) { return nil, nil }), ErrNilError)
+	checkErr(t, Err[int](io.EOF).MapErr(nil), ufunction.ErrNilFunctional)
+}
+
+func TestRecover(t *testing.T) {
+	zero := func(err error) (int, error) {
+		if errors.Is(err, io.EOF) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	checkOk(t, Err[int](io.EOF).Recover(zero), 0)
+	checkErr(t, Err[int](io.ErrClosedPipe).Recover(zero), io.ErrClosedPipe)
+	checkOk(t, Ok(1).Recover(zero), 1)
+	checkErr(t, Err[int](io.EOF).Recover(nil), ufunction.ErrNilFunctional)
+}
+
+func TestAccessors(t *testing.T) {
+	if got := Err[int](io.EOF).OrElse(2); got != 2 {
+		t.Errorf("want=%d, got=%d", 2, got)
+	}
+	if got := Ok(1).OrElse(2); got != 1 {
+		t.Errorf("want=%d, got=%d", 1, got)
+	}
+	if got, err := Err[int](io.EOF).OrElseGet(func() (int, error) { return 2, nil }); got != 2 || err != nil {
+		t.Errorf("want=%d, got=%d, %v", 2, got, err)
+	}
+	if _, err := Err[int](io.EOF).OrElseGet(nil); !errors.Is(err, ufunction.ErrNilFunctional) || !errors.Is(err, io.EOF) {
+		t.Errorf("error must contain %q and %q but %q.", ufunction.ErrNilFunctional, io.EOF, err)
+	}
+
+	var got int
+	if err := Ok(1).IfOk(func(v int) error { got = v; return nil }); err != nil || got != 1 {
+		t.Errorf("want=%d, got=%d, %v", 1, got, err)
+	}
+	if err := Err[int](io.EOF).IfOk(func(int) error { t.Error("must not be called."); return nil }); err != io.EOF {
+		t.Errorf("want=%q, got=%q", io.EOF, err)
+	}
+	if err := Ok(1).IfOk(nil); err != ufunction.ErrNilFunctional {
+		t.Errorf("want=%q, got=%q", ufunction.ErrNilFunctional, err)
+	}
+
+	if got := Ok(1).String(); got != "Ok[1]" {
+		t.Errorf("want=%q, got=%q", "Ok[1]", got)
+	}
+	if got := Err[int](io.EOF).String(); got != "Err[EOF]" {
+		t.Errorf("want=%q, got=%q", "Err[EOF]", got)
+	}
+}
+
+func TestOptional(t *testing.T) {
+	checkOk(t, FromOptional(util.Of(1)), 1)
+	checkErr(t, FromOptional(util.Empty[int]()), util.ErrEmpty)
+	checkErr(t, FromOptional[int](nil), util.ErrEmpty)
+
+	o := util.Of(1).Filter(func(int) (bool, error) { return false, nil })
+	r := FromOptional(o)
+	checkErr(t, r, util.ErrPredicateFailed)
+	if !r.ToOptional().Equal(o) {
+		t.Errorf("round trip must keep the reason: want=%v, got=%v", o.Error(), r.ToOptional().Error())
+	}
+
+	back := Ok(1).ToOptional()
+	if v, err := back.Get(); v != 1 || err != nil {
+		t.Errorf("want=%d, got=%d, %v", 1, v, err)
+	}
+	if err := Err[int](io.EOF).ToOptional().Error(); err != io.EOF {
+		t.Errorf("want=%q, got=%q", io.EOF, err)
+	}
+	if v, err := Ok[*int](nil).ToOptional().Get(); v != nil || err != nil {
+		t.Errorf("want=(nil, nil), got=(%v, %v)", v, err)
+	}
+	checkOk(t, FromOptional(Ok[*int](nil).ToOptional()), nil)
+}